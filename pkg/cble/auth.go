@@ -0,0 +1,206 @@
+package cble
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	sync "sync"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegistrationAuthenticator authenticates providers when they call RegisterProvider (and when they
+// present their credential on later calls, see ProviderCredentials). Returning an error rejects the
+// call with codes.Unauthenticated
+type RegistrationAuthenticator interface {
+	Authenticate(ctx context.Context, request *RegistrationRequest) error
+}
+
+// PreSharedKeyAuthenticator authenticates providers using a pre-shared key per provider name
+type PreSharedKeyAuthenticator struct {
+	// Map of provider names to their pre-shared keys
+	Keys map[string]string
+}
+
+func (a PreSharedKeyAuthenticator) Authenticate(ctx context.Context, request *RegistrationRequest) error {
+	key, ok := a.Keys[request.Name]
+	if !ok {
+		return fmt.Errorf("unknown provider %s", request.Name)
+	}
+	if subtle.ConstantTimeCompare([]byte(request.Credential), []byte(key)) != 1 {
+		return fmt.Errorf("invalid credential for provider %s", request.Name)
+	}
+	return nil
+}
+
+// SignedTokenAuthenticator authenticates providers using an expiring token signed with a shared
+// secret (see NewSignedCredential)
+type SignedTokenAuthenticator struct {
+	Secret []byte
+}
+
+func (a SignedTokenAuthenticator) Authenticate(ctx context.Context, request *RegistrationRequest) error {
+	expiry, signature, ok := strings.Cut(request.Credential, ".")
+	if !ok {
+		return fmt.Errorf("malformed credential")
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return fmt.Errorf("malformed credential expiry: %v", err)
+	}
+	if time.Now().Unix() > expiresAt {
+		return fmt.Errorf("credential expired")
+	}
+	if !hmac.Equal([]byte(signature), []byte(signCredential(a.Secret, request.Name, expiry))) {
+		return fmt.Errorf("invalid credential signature")
+	}
+	return nil
+}
+
+// NewSignedCredential returns a credential for the provider name which is valid for the given
+// duration, for use with SignedTokenAuthenticator
+func NewSignedCredential(secret []byte, name string, validFor time.Duration) string {
+	expiry := strconv.FormatInt(time.Now().Add(validFor).Unix(), 10)
+	return expiry + "." + signCredential(secret, name, expiry)
+}
+
+// signCredential returns the hex encoded HMAC-SHA256 of the provider name and expiry
+func signCredential(secret []byte, name string, expiry string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(name + "." + expiry))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewProviderToken returns a new bearer token to issue in a RegistrationReply
+func NewProviderToken() (string, error) {
+	return common.NewToken()
+}
+
+// ProviderCredentials returns the call option presenting the token issued in the RegistrationReply
+// (or the registration credential if none was issued) on UnregisterProvider and Heartbeat calls,
+// which CBLE requires when it authenticates providers
+func ProviderCredentials(token string) grpc.CallOption {
+	return grpc.PerRPCCredentials(common.TokenCredentials{Token: token})
+}
+
+type authenticatedProviderKey struct{}
+
+// providerSession is an accepted registration, used to authenticate the provider's later calls
+type providerSession struct {
+	name  string
+	token string
+}
+
+// registrationAuth authenticates RegisterProvider calls with the authenticator, and UnregisterProvider
+// and Heartbeat calls with the token issued to the provider on registration (or a registration
+// credential the authenticator accepts)
+type registrationAuth struct {
+	authenticator RegistrationAuthenticator
+
+	mu sync.Mutex
+	// Accepted registrations keyed by provider ID
+	sessions map[string]providerSession
+}
+
+func newRegistrationAuth(authenticator RegistrationAuthenticator) *registrationAuth {
+	return &registrationAuth{
+		authenticator: authenticator,
+		sessions:      make(map[string]providerSession),
+	}
+}
+
+// unaryInterceptor authenticates the provider calling RegisterProvider, UnregisterProvider or Heartbeat
+func (a *registrationAuth) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	switch info.FullMethod {
+	case CBLE_RegisterProvider_FullMethodName:
+		request := req.(*RegistrationRequest)
+		if err := a.authenticator.Authenticate(ctx, request); err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "registration rejected: %v", err)
+		}
+		reply, err := handler(context.WithValue(ctx, authenticatedProviderKey{}, request.Name), req)
+		if reply, ok := reply.(*RegistrationReply); ok && err == nil && reply.Success {
+			a.mu.Lock()
+			a.sessions[request.Id] = providerSession{name: request.Name, token: reply.Token}
+			a.mu.Unlock()
+		}
+		return reply, err
+	case CBLE_UnregisterProvider_FullMethodName:
+		request := req.(*UnregistrationRequest)
+		name, err := a.authenticate(ctx, request.Id, request.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "unregistration rejected: %v", err)
+		}
+		reply, err := handler(context.WithValue(ctx, authenticatedProviderKey{}, name), req)
+		if reply, ok := reply.(*UnregistrationReply); ok && err == nil && reply.Success {
+			a.mu.Lock()
+			delete(a.sessions, request.Id)
+			a.mu.Unlock()
+		}
+		return reply, err
+	case CBLE_Heartbeat_FullMethodName:
+		request := req.(*HeartbeatRequest)
+		a.mu.Lock()
+		_, known := a.sessions[request.Id]
+		a.mu.Unlock()
+		if !known {
+			// Heartbeats do not carry the provider name to check a credential against, so ask the
+			// provider to register again (which authenticates it) instead
+			return &HeartbeatReply{Success: false, Reregister: true}, nil
+		}
+		name, err := a.authenticate(ctx, request.Id, "")
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "heartbeat rejected: %v", err)
+		}
+		return handler(context.WithValue(ctx, authenticatedProviderKey{}, name), req)
+	}
+	return handler(ctx, req)
+}
+
+// authenticate checks the bearer token of the call is the token issued to the provider with the ID,
+// or a registration credential for it the authenticator accepts, and returns the provider's name.
+// The name is only used for providers which are not registered
+func (a *registrationAuth) authenticate(ctx context.Context, id string, name string) (string, error) {
+	bearer, ok := common.BearerTokenFromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("missing bearer token")
+	}
+	a.mu.Lock()
+	session, known := a.sessions[id]
+	a.mu.Unlock()
+	if known {
+		if session.token != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(session.token)) == 1 {
+			return session.name, nil
+		}
+		// The credential must be for the name the provider registered with
+		name = session.name
+	}
+	if name == "" {
+		return "", fmt.Errorf("unknown provider %s", id)
+	}
+	if err := a.authenticator.Authenticate(ctx, &RegistrationRequest{Id: id, Name: name, Credential: bearer}); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// metricsLabel labels calls with the name of the provider authenticated by its credential or token,
+// or else the common name of its verified client certificate. Names which have not been
+// authenticated are never used, so callers can't create unbounded metric series
+func metricsLabel(ctx context.Context, req interface{}) string {
+	if name, ok := ctx.Value(authenticatedProviderKey{}).(string); ok && name != "" {
+		return name
+	}
+	if identity, ok := common.PeerIdentityFromContext(ctx); ok && identity.Verified && identity.Subject.CommonName != "" {
+		return identity.Subject.CommonName
+	}
+	return "unknown"
+}
@@ -0,0 +1,134 @@
+package cble_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/cble"
+	"github.com/cble-platform/cble-provider-grpc/pkg/cbletest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startAuthenticatedCBLE starts a fake CBLE server authenticating the "provider" provider with a
+// pre-shared key and returns a client connected to it
+func startAuthenticatedCBLE(t *testing.T, issueTokens bool) (*cbletest.Server, cble.CBLEClient) {
+	t.Helper()
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	fake := cbletest.Start(t, &cbletest.Options{
+		Socket:        socket,
+		Authenticator: cble.PreSharedKeyAuthenticator{Keys: map[string]string{"provider": "key"}},
+		IssueTokens:   issueTokens,
+	})
+	conn, err := cble.Connect(&cble.CBLEClientOptions{Socket: socket})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return fake, cble.NewCBLEClient(conn)
+}
+
+func TestUnregisterRequiresAuthentication(t *testing.T) {
+	fake, client := startAuthenticatedCBLE(t, true)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	reply, err := client.RegisterProvider(ctx, &cble.RegistrationRequest{Id: "provider-id", Name: "provider", Credential: "key"})
+	if err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	request := &cble.UnregistrationRequest{Id: "provider-id", Name: "provider"}
+
+	if _, err := client.UnregisterProvider(ctx, request); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unauthenticated unregistration to be rejected, got %v", err)
+	}
+	if _, err := client.UnregisterProvider(ctx, request, cble.ProviderCredentials("wrong")); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unregistration with the wrong token to be rejected, got %v", err)
+	}
+	if _, ok := fake.Provider("provider-id"); !ok {
+		t.Fatalf("expected the provider to still be registered")
+	}
+	if len(fake.Unregistrations()) != 0 {
+		t.Errorf("expected rejected unregistrations not to reach the server")
+	}
+
+	unregisterReply, err := client.UnregisterProvider(ctx, request, cble.ProviderCredentials(reply.Token))
+	if err != nil {
+		t.Fatalf("UnregisterProvider with the issued token failed: %v", err)
+	}
+	if !unregisterReply.Success {
+		t.Errorf("expected the unregistration to succeed")
+	}
+	if _, ok := fake.Provider("provider-id"); ok {
+		t.Errorf("expected the provider to be unregistered")
+	}
+	if registrations := fake.Registrations(); len(registrations) != 1 {
+		t.Errorf("expected only the registration to be recorded, got %d", len(registrations))
+	}
+}
+
+func TestUnregisterWithRegistrationCredential(t *testing.T) {
+	fake, client := startAuthenticatedCBLE(t, false)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.RegisterProvider(ctx, &cble.RegistrationRequest{Id: "provider-id", Name: "provider", Credential: "key"}); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	// The name of the request must not override the name the provider registered with
+	impersonating := &cble.UnregistrationRequest{Id: "provider-id", Name: "other"}
+	if _, err := client.UnregisterProvider(ctx, impersonating, cble.ProviderCredentials("other-key")); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unregistration with another credential to be rejected, got %v", err)
+	}
+
+	request := &cble.UnregistrationRequest{Id: "provider-id", Name: "provider"}
+	if _, err := client.UnregisterProvider(ctx, request, cble.ProviderCredentials("key")); err != nil {
+		t.Fatalf("UnregisterProvider with the registration credential failed: %v", err)
+	}
+	if _, ok := fake.Provider("provider-id"); ok {
+		t.Errorf("expected the provider to be unregistered")
+	}
+}
+
+func TestHeartbeatRequiresAuthentication(t *testing.T) {
+	fake, client := startAuthenticatedCBLE(t, true)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Heartbeats from unknown providers are asked to register (which authenticates them)
+	reply, err := client.Heartbeat(ctx, &cble.HeartbeatRequest{Id: "provider-id"})
+	if err != nil {
+		t.Fatalf("Heartbeat failed: %v", err)
+	}
+	if reply.Success || !reply.Reregister {
+		t.Errorf("expected an unknown provider to be asked to re-register, got %v", reply)
+	}
+
+	registerReply, err := client.RegisterProvider(ctx, &cble.RegistrationRequest{Id: "provider-id", Name: "provider", Credential: "key"})
+	if err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	request := &cble.HeartbeatRequest{Id: "provider-id", Status: cble.HealthStatus_HEALTHY}
+	if _, err := client.Heartbeat(ctx, request); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an unauthenticated heartbeat to be rejected, got %v", err)
+	}
+	if _, err := client.Heartbeat(ctx, request, cble.ProviderCredentials("wrong")); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected a heartbeat with the wrong token to be rejected, got %v", err)
+	}
+	if len(fake.Heartbeats()) != 0 {
+		t.Errorf("expected rejected heartbeats not to reach the server")
+	}
+
+	reply, err = client.Heartbeat(ctx, request, cble.ProviderCredentials(registerReply.Token))
+	if err != nil {
+		t.Fatalf("Heartbeat with the issued token failed: %v", err)
+	}
+	if !reply.Success || reply.Reregister {
+		t.Errorf("expected the heartbeat to succeed, got %v", reply)
+	}
+	if len(fake.Heartbeats()) != 1 {
+		t.Errorf("expected the authenticated heartbeat to be recorded")
+	}
+}
@@ -25,6 +25,7 @@ const (
 	CBLE_Handshake_FullMethodName          = "/CBLE/Handshake"
 	CBLE_RegisterProvider_FullMethodName   = "/CBLE/RegisterProvider"
 	CBLE_UnregisterProvider_FullMethodName = "/CBLE/UnregisterProvider"
+	CBLE_Heartbeat_FullMethodName          = "/CBLE/Heartbeat"
 )
 
 // CBLEClient is the client API for CBLE service.
@@ -35,6 +36,7 @@ type CBLEClient interface {
 	Handshake(ctx context.Context, in *common.HandshakeRequest, opts ...grpc.CallOption) (*common.HandshakeReply, error)
 	RegisterProvider(ctx context.Context, in *RegistrationRequest, opts ...grpc.CallOption) (*RegistrationReply, error)
 	UnregisterProvider(ctx context.Context, in *UnregistrationRequest, opts ...grpc.CallOption) (*UnregistrationReply, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatReply, error)
 }
 
 type cBLEClient struct {
@@ -72,6 +74,15 @@ func (c *cBLEClient) UnregisterProvider(ctx context.Context, in *UnregistrationR
 	return out, nil
 }
 
+func (c *cBLEClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatReply, error) {
+	out := new(HeartbeatReply)
+	err := c.cc.Invoke(ctx, CBLE_Heartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CBLEServer is the server API for CBLE service.
 // All implementations must embed UnimplementedCBLEServer
 // for forward compatibility
@@ -80,6 +91,7 @@ type CBLEServer interface {
 	Handshake(context.Context, *common.HandshakeRequest) (*common.HandshakeReply, error)
 	RegisterProvider(context.Context, *RegistrationRequest) (*RegistrationReply, error)
 	UnregisterProvider(context.Context, *UnregistrationRequest) (*UnregistrationReply, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatReply, error)
 	mustEmbedUnimplementedCBLEServer()
 }
 
@@ -96,6 +108,9 @@ func (UnimplementedCBLEServer) RegisterProvider(context.Context, *RegistrationRe
 func (UnimplementedCBLEServer) UnregisterProvider(context.Context, *UnregistrationRequest) (*UnregistrationReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterProvider not implemented")
 }
+func (UnimplementedCBLEServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
 func (UnimplementedCBLEServer) mustEmbedUnimplementedCBLEServer() {}
 
 // UnsafeCBLEServer may be embedded to opt out of forward compatibility for this service.
@@ -163,6 +178,24 @@ func _CBLE_UnregisterProvider_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CBLE_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CBLEServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CBLE_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CBLEServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CBLE_ServiceDesc is the grpc.ServiceDesc for CBLE service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -182,6 +215,10 @@ var CBLE_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterProvider",
 			Handler:    _CBLE_UnregisterProvider_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _CBLE_Heartbeat_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cble.proto",
@@ -6,6 +6,7 @@ import (
 	"net"
 
 	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/tracing"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -16,12 +17,21 @@ type CBLEClientOptions struct {
 	TLS    bool
 	CAFile string
 	Socket string
+	// The client certificate and key to present to the server (for mTLS)
+	CertFile string
+	KeyFile  string
+	// The name to verify the server certificate against, defaults to "localhost"
+	ServerName string
+	// Any additional options to pass to grpc.Dial (e.g. client interceptors)
+	DialOptions []grpc.DialOption
 }
 
 var defaultClientOptions = &CBLEClientOptions{
-	TLS:    false,
-	CAFile: "",
-	Socket: "/tmp/cble-server",
+	TLS:      false,
+	CAFile:   "",
+	Socket:   "/tmp/cble-server",
+	CertFile: "",
+	KeyFile:  "",
 }
 
 func DefaultConnect() (*grpc.ClientConn, error) {
@@ -36,18 +46,22 @@ func Connect(options *CBLEClientOptions) (*grpc.ClientConn, error) {
 		}),
 	}
 	if options.TLS {
-		if options.CAFile == "" {
-			return nil, fmt.Errorf("CA file must be provided for TLS")
-		}
-		creds, err := credentials.NewClientTLSFromFile(options.CAFile, "")
+		tlsConfig, err := common.NewClientTLSConfig(options.CAFile, options.CertFile, options.KeyFile)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create TLS credentials: %v", err)
 		}
-		opts = append(opts, grpc.WithTransportCredentials(creds))
+		tlsConfig.ServerName = options.ServerName
+		if tlsConfig.ServerName == "" {
+			// The dial target of a unix socket is its path, which is never a certificate name
+			tlsConfig.ServerName = "localhost"
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
+	opts = append(opts, options.DialOptions...)
+
 	conn, err := grpc.Dial(options.Socket, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("fail to dial: %v", err)
@@ -56,8 +70,10 @@ func Connect(options *CBLEClientOptions) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+// NewClient returns a CBLEClient once the handshake with CBLE succeeds. Calls made with the client
+// propagate the OpenTelemetry trace context of their context to CBLE
 func NewClient(ctx context.Context, conn grpc.ClientConnInterface) (CBLEClient, error) {
-	client := NewCBLEClient(conn)
+	client := NewCBLEClient(tracing.ClientConn(conn, requestAttributes))
 	reply, err := client.Handshake(ctx, &common.HandshakeRequest{
 		ClientVersion: VERSION,
 	})
@@ -0,0 +1,133 @@
+package cble
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// processStart is used to report the uptime of the provider process
+var processStart = time.Now()
+
+type HeartbeatOptions struct {
+	// How often to send a heartbeat (defaults to 30 seconds)
+	Interval time.Duration
+	// The registration to send again if CBLE has forgotten about the provider
+	Registration *RegistrationRequest
+	// The token issued in the RegistrationReply, presented on every heartbeat. The registration
+	// credential is presented instead if empty
+	Token string
+	// Returns a fresh credential to re-register with (e.g. NewSignedCredential, as signed credentials
+	// expire). Registration.Credential is sent as-is if nil
+	Credential func() (string, error)
+	// Returns the current health of the provider (defaults to always HEALTHY)
+	Status func() HealthStatus
+	// Returns the number of in-flight operations (e.g. provider.InFlightOperations)
+	InFlightOperations func() int
+	// Called with the reply whenever the provider re-registers with CBLE. CBLE issues a new token on
+	// re-registration, so store it in the server's token source (see common.AtomicToken)
+	OnReregister func(*RegistrationReply)
+}
+
+// StartHeartbeat starts a background goroutine which sends heartbeats to CBLE until the context is
+// cancelled. If CBLE replies that it does not know of the provider (e.g. CBLE restarted), the
+// provider is registered again using options.Registration, which is required
+func StartHeartbeat(ctx context.Context, client CBLEClient, options *HeartbeatOptions) error {
+	if options == nil || options.Registration == nil {
+		return fmt.Errorf("heartbeat registration must not be nil")
+	}
+	interval := options.Interval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		// The token is replaced whenever the provider re-registers
+		token := options.Token
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				token = sendHeartbeat(ctx, client, options, interval, token)
+			}
+		}
+	}()
+	return nil
+}
+
+// sendHeartbeat sends a single heartbeat (timing out after the interval) presenting the token and
+// re-registers if needed, returning the token to present on the next heartbeat
+func sendHeartbeat(ctx context.Context, client CBLEClient, options *HeartbeatOptions, interval time.Duration, token string) string {
+	logger := logrus.WithField("component", "CBLE_GRPC_HEARTBEAT")
+
+	request := &HeartbeatRequest{
+		Id:            options.Registration.GetId(),
+		UptimeSeconds: uint64(time.Since(processStart).Seconds()),
+		Status:        HealthStatus_HEALTHY,
+	}
+	if options.Status != nil {
+		request.Status = options.Status()
+	}
+	if options.InFlightOperations != nil {
+		request.InFlightOperations = uint32(options.InFlightOperations())
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, interval)
+	defer cancel()
+
+	var opts []grpc.CallOption
+	if token != "" {
+		opts = append(opts, ProviderCredentials(token))
+	} else if credential, err := registrationCredential(options); err != nil {
+		logger.Errorf("failed to refresh credential: %v", err)
+		return token
+	} else if credential != "" {
+		opts = append(opts, ProviderCredentials(credential))
+	}
+	reply, err := client.Heartbeat(timeoutCtx, request, opts...)
+	if err != nil {
+		logger.Warnf("failed to send heartbeat: %v", err)
+		return token
+	}
+	if !reply.Reregister {
+		return token
+	}
+
+	logger.Warnf("CBLE does not know of provider %s, re-registering...", request.Id)
+	credential, err := registrationCredential(options)
+	if err != nil {
+		logger.Errorf("failed to refresh credential: %v", err)
+		return token
+	}
+	registration := proto.Clone(options.Registration).(*RegistrationRequest)
+	registration.Credential = credential
+	registerReply, err := client.RegisterProvider(timeoutCtx, registration)
+	if err != nil {
+		logger.Errorf("failed to re-register provider: %v", err)
+		return token
+	}
+	if !registerReply.Success {
+		logger.Errorf("failed to re-register provider: registration was unsuccessful")
+		return token
+	}
+	if options.OnReregister != nil {
+		options.OnReregister(registerReply)
+	}
+	return registerReply.Token
+}
+
+// registrationCredential returns a fresh credential if options.Credential is set, otherwise the
+// credential of the registration
+func registrationCredential(options *HeartbeatOptions) (string, error) {
+	if options.Credential != nil {
+		return options.Credential()
+	}
+	return options.Registration.Credential, nil
+}
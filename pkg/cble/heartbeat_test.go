@@ -0,0 +1,88 @@
+package cble_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/cble"
+	"github.com/cble-platform/cble-provider-grpc/pkg/cbletest"
+)
+
+func TestHeartbeatReregistersWithFreshCredential(t *testing.T) {
+	secret := []byte("secret")
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	fake := cbletest.Start(t, &cbletest.Options{
+		Socket:        socket,
+		Authenticator: cble.SignedTokenAuthenticator{Secret: secret},
+		IssueTokens:   true,
+	})
+
+	conn, err := cble.Connect(&cble.CBLEClientOptions{Socket: socket})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, err := cble.NewClient(ctx, conn)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	var refreshed int32
+	reregistered := make(chan *cble.RegistrationReply, 1)
+	err = cble.StartHeartbeat(ctx, client, &cble.HeartbeatOptions{
+		Interval: 20 * time.Millisecond,
+		Registration: &cble.RegistrationRequest{
+			Id:   "provider-id",
+			Name: "provider",
+			// Already expired, so re-registering without refreshing would be rejected
+			Credential: cble.NewSignedCredential(secret, "provider", -time.Minute),
+		},
+		Credential: func() (string, error) {
+			atomic.AddInt32(&refreshed, 1)
+			return cble.NewSignedCredential(secret, "provider", time.Minute), nil
+		},
+		OnReregister: func(reply *cble.RegistrationReply) {
+			select {
+			case reregistered <- reply:
+			default:
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("StartHeartbeat failed: %v", err)
+	}
+
+	select {
+	case reply := <-reregistered:
+		if reply.Token == "" {
+			t.Errorf("expected a new token on re-registration")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("provider did not re-register")
+	}
+	if atomic.LoadInt32(&refreshed) == 0 {
+		t.Errorf("expected the credential to be refreshed")
+	}
+	if _, ok := fake.Provider("provider-id"); !ok {
+		t.Errorf("expected the provider to be registered")
+	}
+	for _, heartbeat := range fake.Heartbeats() {
+		if heartbeat.Status != cble.HealthStatus_HEALTHY {
+			t.Errorf("expected heartbeats to default to HEALTHY, got %v", heartbeat.Status)
+		}
+	}
+}
+
+func TestStartHeartbeatRequiresRegistration(t *testing.T) {
+	if err := cble.StartHeartbeat(context.Background(), nil, &cble.HeartbeatOptions{}); err == nil {
+		t.Errorf("expected StartHeartbeat to fail without a registration")
+	}
+	if err := cble.StartHeartbeat(context.Background(), nil, nil); err == nil {
+		t.Errorf("expected StartHeartbeat to fail without options")
+	}
+}
@@ -2,12 +2,15 @@ package cble
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"os"
 	sync "sync"
 
 	"github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/metrics"
+	"github.com/cble-platform/cble-provider-grpc/pkg/tracing"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/mod/semver"
 	"google.golang.org/grpc"
@@ -23,6 +26,22 @@ type CBLEServerOptions struct {
 	CertFile string
 	KeyFile  string
 	Socket   string
+	// The CA bundle to verify client certificates against (enables mTLS)
+	CAFile string
+	// The client certificate policy, defaults to tls.RequireAndVerifyClientCert if CAFile is set
+	ClientAuth tls.ClientAuthType
+	// Authenticates providers calling RegisterProvider (all calls are accepted if nil). Providers must
+	// then present the token issued on registration on UnregisterProvider and Heartbeat calls (see
+	// ProviderCredentials)
+	Authenticator RegistrationAuthenticator
+	// Interceptors to run (in order) after authentication and before the CBLE handlers
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+	// Any additional options to pass to grpc.NewServer
+	ExtraOptions []grpc.ServerOption
+	// Records Prometheus metrics for every call if set (labelled with the provider name authenticated
+	// by the Authenticator, else the common name of the verified client certificate, or "unknown")
+	Metrics *metrics.Metrics
 }
 
 var defaultServerOptions = &CBLEServerOptions{
@@ -38,24 +57,40 @@ func DefaultServe(ctx context.Context, server CBLEServer) error {
 
 // Serve is a blocking call which returns an error if unable to serve
 func Serve(ctx context.Context, server CBLEServer, options *CBLEServerOptions) error {
-	lis, err := net.Listen("unix", options.Socket)
-	if err != nil {
-		return fmt.Errorf("failed to listen: %v", err)
-	}
+	// Build the TLS config before listening so a bad cert or CA does not leave the socket behind
 	var opts []grpc.ServerOption
 	if options.TLS {
-		if options.CertFile == "" || options.KeyFile == "" {
-			return fmt.Errorf("must provider a certificate and key file if using TLS")
-		}
-		creds, err := credentials.NewServerTLSFromFile(options.CertFile, options.KeyFile)
+		tlsConfig, err := common.NewServerTLSConfig(options.CertFile, options.KeyFile, options.CAFile, options.ClientAuth)
 		if err != nil {
 			return err
 		}
-		opts = []grpc.ServerOption{grpc.Creds(creds)}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor(requestAttributes), common.PeerIdentityUnaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{tracing.StreamServerInterceptor(requestAttributes), common.PeerIdentityStreamInterceptor}
+	if options.Authenticator != nil {
+		unaryInterceptors = append(unaryInterceptors, newRegistrationAuth(options.Authenticator).unaryInterceptor)
 	}
+	// Metrics are recorded after authentication so only authenticated provider names become labels
+	if options.Metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, options.Metrics.UnaryServerInterceptorFunc(metricsLabel))
+		streamInterceptors = append(streamInterceptors, options.Metrics.StreamServerInterceptorFunc(metricsLabel))
+	}
+	unaryInterceptors = append(unaryInterceptors, options.UnaryInterceptors...)
+	streamInterceptors = append(streamInterceptors, options.StreamInterceptors...)
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	opts = append(opts, options.ExtraOptions...)
 	grpcServer := grpc.NewServer(opts...)
 	RegisterCBLEServer(grpcServer, server)
 
+	lis, err := net.Listen("unix", options.Socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %v", err)
+	}
+
 	// Setup graceful shutdown signals
 	wg := sync.WaitGroup{}
 	wg.Add(1)
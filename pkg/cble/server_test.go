@@ -0,0 +1,161 @@
+package cble_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/cble"
+	"github.com/cble-platform/cble-provider-grpc/pkg/metrics"
+	"google.golang.org/grpc"
+)
+
+type acceptingServer struct {
+	cble.DefaultCBLEServer
+}
+
+func (acceptingServer) RegisterProvider(ctx context.Context, request *cble.RegistrationRequest) (*cble.RegistrationReply, error) {
+	return &cble.RegistrationReply{Success: true}, nil
+}
+
+func (acceptingServer) UnregisterProvider(ctx context.Context, request *cble.UnregistrationRequest) (*cble.UnregistrationReply, error) {
+	return &cble.UnregistrationReply{Success: true}, nil
+}
+
+func (acceptingServer) Heartbeat(ctx context.Context, request *cble.HeartbeatRequest) (*cble.HeartbeatReply, error) {
+	return &cble.HeartbeatReply{Success: true}, nil
+}
+
+func TestMetricsOnlyLabelAuthenticatedProviders(t *testing.T) {
+	m := metrics.NewMetrics()
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- cble.Serve(ctx, acceptingServer{}, &cble.CBLEServerOptions{
+			Socket:        socket,
+			Authenticator: cble.PreSharedKeyAuthenticator{Keys: map[string]string{"good": "key"}},
+			Metrics:       m,
+		})
+	}()
+	defer func() {
+		cancel()
+		<-served
+	}()
+
+	conn, err := cble.Connect(&cble.CBLEClientOptions{Socket: socket})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	client := cble.NewCBLEClient(conn)
+	callCtx, callCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer callCancel()
+
+	// The server may not be listening yet
+	if _, err := client.Heartbeat(callCtx, &cble.HeartbeatRequest{Id: "id"}, grpc.WaitForReady(true)); err != nil {
+		t.Fatalf("Heartbeat failed: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		// Rejected registrations with arbitrary names must not create label values
+		client.RegisterProvider(callCtx, &cble.RegistrationRequest{Name: "evil-" + string(rune('a'+i)), Credential: "key"})
+	}
+	if _, err := client.RegisterProvider(callCtx, &cble.RegistrationRequest{Name: "good", Credential: "key"}); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+
+	labels := providerLabels(t, m, "")
+	// The heartbeat of the unregistered provider is answered before reaching the metrics interceptor
+	if len(labels) != 1 || !labels["good"] {
+		t.Errorf("expected only the good provider label, got %v", labels)
+	}
+}
+
+func TestMetricsLabelAuthenticatedCalls(t *testing.T) {
+	m := metrics.NewMetrics()
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- cble.Serve(ctx, acceptingServer{}, &cble.CBLEServerOptions{
+			Socket:        socket,
+			Authenticator: cble.PreSharedKeyAuthenticator{Keys: map[string]string{"good": "key"}},
+			Metrics:       m,
+		})
+	}()
+	defer func() {
+		cancel()
+		<-served
+	}()
+
+	conn, err := cble.Connect(&cble.CBLEClientOptions{Socket: socket})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	client := cble.NewCBLEClient(conn)
+	callCtx, callCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer callCancel()
+
+	// The server may not be listening yet
+	if _, err := client.RegisterProvider(callCtx, &cble.RegistrationRequest{Id: "id", Name: "good", Credential: "key"}, grpc.WaitForReady(true)); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+	// No token was issued, so the provider presents its registration credential
+	if _, err := client.Heartbeat(callCtx, &cble.HeartbeatRequest{Id: "id"}, cble.ProviderCredentials("key")); err != nil {
+		t.Fatalf("Heartbeat failed: %v", err)
+	}
+	if _, err := client.UnregisterProvider(callCtx, &cble.UnregistrationRequest{Id: "id", Name: "good"}, cble.ProviderCredentials("key")); err != nil {
+		t.Fatalf("UnregisterProvider failed: %v", err)
+	}
+
+	for _, method := range []string{"RegisterProvider", "Heartbeat", "UnregisterProvider"} {
+		if labels := providerLabels(t, m, method); len(labels) != 1 || !labels["good"] {
+			t.Errorf("expected %s to only be labelled with the good provider, got %v", method, labels)
+		}
+	}
+}
+
+// providerLabels returns the provider labels of the requests recorded for the method (any method
+// if empty)
+func providerLabels(t *testing.T, m *metrics.Metrics, method string) map[string]bool {
+	t.Helper()
+	families, err := m.Registry().Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	labels := map[string]bool{}
+	for _, family := range families {
+		if family.GetName() != "cble_grpc_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			values := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				values[label.GetName()] = label.GetValue()
+			}
+			if method == "" || values["method"] == method {
+				labels[values["provider"]] = true
+			}
+		}
+	}
+	return labels
+}
+
+func TestServeBadTLSLeavesNoSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	err := cble.Serve(context.Background(), acceptingServer{}, &cble.CBLEServerOptions{
+		TLS:      true,
+		CertFile: filepath.Join(t.TempDir(), "missing.crt"),
+		KeyFile:  filepath.Join(t.TempDir(), "missing.key"),
+		Socket:   socket,
+	})
+	if err == nil {
+		t.Fatal("expected Serve to fail with a missing certificate")
+	}
+	if _, err := os.Stat(socket); !os.IsNotExist(err) {
+		t.Errorf("expected no socket to be left behind, got %v", err)
+	}
+}
@@ -0,0 +1,17 @@
+package cble
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// requestAttributes returns the span attributes identifying the provider of a request
+func requestAttributes(req interface{}) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	if req, ok := req.(interface{ GetId() string }); ok && req.GetId() != "" {
+		attributes = append(attributes, attribute.String("cble.provider.id", req.GetId()))
+	}
+	if req, ok := req.(interface{ GetName() string }); ok && req.GetName() != "" {
+		attributes = append(attributes, attribute.String("cble.provider.name", req.GetName()))
+	}
+	return attributes
+}
@@ -0,0 +1,329 @@
+// Package cbletest provides an in-memory fake CBLE server for provider integration tests. The fake
+// records registrations, assigns socket IDs, can be scripted to fail or reject registrations and
+// can dial back into registered providers to drive deploy/destroy scenarios end-to-end
+package cbletest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	sync "sync"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/cble"
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Options struct {
+	// The socket to serve on, defaults to /tmp/cble-server so cble.DefaultConnect can be used.
+	// Tests sharing a socket must not run in parallel
+	Socket string
+	// Authenticates providers calling RegisterProvider, UnregisterProvider and Heartbeat (all calls are
+	// accepted if nil, see cble.CBLEServerOptions)
+	Authenticator cble.RegistrationAuthenticator
+	// Whether to issue a bearer token to providers on registration
+	IssueTokens bool
+	// The options used to dial back into providers. The socket ID, network, address and token are
+	// filled in from the registration
+	ProviderClient *provider.ProviderClientOptions
+}
+
+// Provider is a provider registered with the fake
+type Provider struct {
+	Request  *cble.RegistrationRequest
+	SocketID string
+	Token    string
+}
+
+type Server struct {
+	cble.DefaultCBLEServer
+
+	t       testing.TB
+	options *Options
+
+	mu              sync.Mutex
+	providers       map[string]*Provider
+	registrations   []*cble.RegistrationRequest
+	unregistrations []*cble.UnregistrationRequest
+	heartbeats      []*cble.HeartbeatRequest
+	// Closed and replaced whenever a provider registers
+	registered chan struct{}
+	// Scripted registration outcomes
+	failCount   int
+	failErr     error
+	rejectCount int
+}
+
+// Start serves the fake CBLE server and returns once it is ready for connections. The server is
+// stopped when the test completes
+func Start(t testing.TB, options *Options) *Server {
+	t.Helper()
+	if options == nil {
+		options = &Options{}
+	}
+	socket := options.Socket
+	if socket == "" {
+		socket = "/tmp/cble-server"
+	}
+	s := &Server{
+		t:          t,
+		options:    options,
+		providers:  make(map[string]*Provider),
+		registered: make(chan struct{}),
+	}
+
+	// Remove any socket left behind by a previous run, as listening on it would fail
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		t.Fatalf("failed to remove stale socket %s: %v", socket, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var serveErr error
+	done := make(chan struct{})
+	serverOptions := &cble.CBLEServerOptions{
+		Socket: socket,
+	}
+	if options.Authenticator != nil {
+		// Registrations are recorded before authenticating so rejected ones are recorded too
+		serverOptions.Authenticator = recordingAuthenticator{server: s, authenticator: options.Authenticator}
+	}
+	go func() {
+		serveErr = cble.Serve(ctx, s, serverOptions)
+		close(done)
+	}()
+
+	// Wait until the socket accepts connections so clients can connect straight away
+	timeout := time.After(10 * time.Second)
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if conn, err := net.DialTimeout("unix", socket, time.Second); err == nil {
+			conn.Close()
+			break
+		}
+		select {
+		case <-done:
+			cancel()
+			t.Fatalf("failed to serve fake CBLE server: %v", serveErr)
+		case <-timeout:
+			cancel()
+			t.Fatalf("fake CBLE server socket %s was not ready in time", socket)
+		case <-ticker.C:
+		}
+	}
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		if serveErr != nil {
+			t.Errorf("fake CBLE server failed: %v", serveErr)
+		}
+	})
+	return s
+}
+
+// FailRegistrations makes the next n registrations fail with the error (all registrations if n < 0).
+// The error defaults to codes.Unavailable if nil
+func (s *Server) FailRegistrations(n int, err error) {
+	if err == nil {
+		err = status.Error(codes.Unavailable, "registration failed")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failCount = n
+	s.failErr = err
+}
+
+// RejectRegistrations makes the next n registrations return an unsuccessful reply (all
+// registrations if n < 0)
+func (s *Server) RejectRegistrations(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectCount = n
+}
+
+// Registrations returns every RegisterProvider request received (including failed ones and those
+// rejected by the Authenticator)
+func (s *Server) Registrations() []*cble.RegistrationRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*cble.RegistrationRequest(nil), s.registrations...)
+}
+
+// Unregistrations returns every authenticated UnregisterProvider request received
+func (s *Server) Unregistrations() []*cble.UnregistrationRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*cble.UnregistrationRequest(nil), s.unregistrations...)
+}
+
+// Heartbeats returns every authenticated Heartbeat request received from a registered provider
+func (s *Server) Heartbeats() []*cble.HeartbeatRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*cble.HeartbeatRequest(nil), s.heartbeats...)
+}
+
+// Providers returns the currently registered providers
+func (s *Server) Providers() []*Provider {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	providers := make([]*Provider, 0, len(s.providers))
+	for _, p := range s.providers {
+		providers = append(providers, p)
+	}
+	return providers
+}
+
+// Provider returns the registered provider with the ID
+func (s *Server) Provider(id string) (*Provider, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.providers[id]
+	return p, ok
+}
+
+// WaitForProvider blocks until a provider with the name is registered or the context is done
+func (s *Server) WaitForProvider(ctx context.Context, name string) (*Provider, error) {
+	for {
+		s.mu.Lock()
+		for _, p := range s.providers {
+			if p.Request.Name == name {
+				s.mu.Unlock()
+				return p, nil
+			}
+		}
+		registered := s.registered
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("provider %s did not register: %v", name, ctx.Err())
+		case <-registered:
+		}
+	}
+}
+
+// Client dials back into the registered provider with the ID and returns a ProviderClient once the
+// handshake succeeds. The connection is closed when the test completes
+func (s *Server) Client(ctx context.Context, id string) (provider.ProviderClient, error) {
+	p, ok := s.Provider(id)
+	if !ok {
+		return nil, fmt.Errorf("provider %s is not registered", id)
+	}
+	options := &provider.ProviderClientOptions{}
+	if s.options.ProviderClient != nil {
+		copied := *s.options.ProviderClient
+		options = &copied
+	}
+	options.SocketID = p.SocketID
+	options.Network = p.Request.GetNetwork()
+	options.Address = p.Request.GetAddress()
+	options.AuthToken = p.Token
+
+	conn, err := provider.Connect(options)
+	if err != nil {
+		return nil, err
+	}
+	s.t.Cleanup(func() { conn.Close() })
+	return provider.NewClient(ctx, conn)
+}
+
+func (s *Server) RegisterProvider(ctx context.Context, request *cble.RegistrationRequest) (*cble.RegistrationReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.options.Authenticator == nil {
+		s.registrations = append(s.registrations, request)
+	}
+
+	if s.failCount != 0 {
+		if s.failCount > 0 {
+			s.failCount--
+		}
+		return nil, s.failErr
+	}
+	if s.rejectCount != 0 {
+		if s.rejectCount > 0 {
+			s.rejectCount--
+		}
+		return &cble.RegistrationReply{
+			Success: false,
+		}, nil
+	}
+
+	p := &Provider{
+		Request:  request,
+		SocketID: uuid.New().String(),
+	}
+	if existing, ok := s.providers[request.Id]; ok {
+		// Re-registrations keep the same socket
+		p.SocketID = existing.SocketID
+	}
+	if s.options.IssueTokens {
+		token, err := cble.NewProviderToken()
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue token: %v", err)
+		}
+		p.Token = token
+	}
+	s.providers[request.Id] = p
+	close(s.registered)
+	s.registered = make(chan struct{})
+
+	return &cble.RegistrationReply{
+		Success:  true,
+		SocketId: p.SocketID,
+		Token:    p.Token,
+	}, nil
+}
+
+func (s *Server) UnregisterProvider(ctx context.Context, request *cble.UnregistrationRequest) (*cble.UnregistrationReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unregistrations = append(s.unregistrations, request)
+
+	if _, ok := s.providers[request.Id]; !ok {
+		return &cble.UnregistrationReply{
+			Success: false,
+		}, nil
+	}
+	delete(s.providers, request.Id)
+	return &cble.UnregistrationReply{
+		Success: true,
+	}, nil
+}
+
+func (s *Server) Heartbeat(ctx context.Context, request *cble.HeartbeatRequest) (*cble.HeartbeatReply, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heartbeats = append(s.heartbeats, request)
+
+	_, ok := s.providers[request.Id]
+	return &cble.HeartbeatReply{
+		Success:    ok,
+		Reregister: !ok,
+	}, nil
+}
+
+// recordingAuthenticator records every registration before authenticating it with the configured
+// authenticator
+type recordingAuthenticator struct {
+	server        *Server
+	authenticator cble.RegistrationAuthenticator
+}
+
+func (a recordingAuthenticator) Authenticate(ctx context.Context, request *cble.RegistrationRequest) error {
+	// Credentials presented on UnregisterProvider and Heartbeat calls are not registrations
+	if method, _ := grpc.Method(ctx); method == cble.CBLE_RegisterProvider_FullMethodName {
+		a.server.mu.Lock()
+		a.server.registrations = append(a.server.registrations, request)
+		a.server.mu.Unlock()
+	}
+	return a.authenticator.Authenticate(ctx, request)
+}
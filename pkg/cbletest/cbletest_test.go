@@ -0,0 +1,54 @@
+package cbletest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/cble"
+	"github.com/cble-platform/cble-provider-grpc/pkg/cbletest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStartRemovesStaleSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	if err := os.WriteFile(socket, nil, 0600); err != nil {
+		t.Fatalf("failed to create stale socket: %v", err)
+	}
+	cbletest.Start(t, &cbletest.Options{Socket: socket})
+}
+
+func TestRecordsRejectedRegistrations(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "cble.sock")
+	fake := cbletest.Start(t, &cbletest.Options{
+		Socket:        socket,
+		Authenticator: cble.PreSharedKeyAuthenticator{Keys: map[string]string{"provider": "key"}},
+	})
+
+	conn, err := cble.Connect(&cble.CBLEClientOptions{Socket: socket})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := cble.NewCBLEClient(conn)
+
+	_, err = client.RegisterProvider(ctx, &cble.RegistrationRequest{Id: "id", Name: "provider", Credential: "wrong"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected the registration to be rejected, got %v", err)
+	}
+	if _, err := client.RegisterProvider(ctx, &cble.RegistrationRequest{Id: "id", Name: "provider", Credential: "key"}); err != nil {
+		t.Fatalf("RegisterProvider failed: %v", err)
+	}
+
+	if registrations := fake.Registrations(); len(registrations) != 2 {
+		t.Errorf("expected 2 recorded registrations, got %d", len(registrations))
+	}
+	if providers := fake.Providers(); len(providers) != 1 {
+		t.Errorf("expected 1 registered provider, got %d", len(providers))
+	}
+}
@@ -0,0 +1,142 @@
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "Bearer "
+)
+
+// NewToken returns a new random bearer token
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// TokenCredentials sends a bearer token with every RPC. Transport security is not required as
+// providers are usually served over a local unix socket, so callers must only use it over other
+// networks with TLS (provider.Connect refuses to send a token over TCP without TLS)
+type TokenCredentials struct {
+	Token string
+	// Returns the token to send, overriding Token
+	Source TokenSource
+}
+
+var _ credentials.PerRPCCredentials = TokenCredentials{}
+
+func (c TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token := c.Token
+	if c.Source != nil {
+		token = c.Source()
+	}
+	return map[string]string{
+		authorizationMetadataKey: bearerPrefix + token,
+	}, nil
+}
+
+func (c TokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// TokenSource returns the bearer token currently expected by a server. It is called on every RPC, so
+// the token can be swapped while the server is running (e.g. after re-registering with CBLE)
+type TokenSource func() string
+
+// StaticToken returns a TokenSource which always returns the token
+func StaticToken(token string) TokenSource {
+	return func() string {
+		return token
+	}
+}
+
+// AtomicToken is a bearer token which can be swapped while a server is running. Pass its Load
+// method as the TokenSource and Store the new token whenever CBLE issues one
+type AtomicToken struct {
+	value atomic.Value
+}
+
+// NewAtomicToken returns an AtomicToken holding the token
+func NewAtomicToken(token string) *AtomicToken {
+	t := &AtomicToken{}
+	t.Store(token)
+	return t
+}
+
+// Load returns the current token
+func (t *AtomicToken) Load() string {
+	token, _ := t.value.Load().(string)
+	return token
+}
+
+// Store replaces the current token
+func (t *AtomicToken) Store(token string) {
+	t.value.Store(token)
+}
+
+// BearerTokenFromContext returns the bearer token presented in the incoming metadata (if any)
+func BearerTokenFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", false
+	}
+	token := strings.TrimPrefix(values[0], bearerPrefix)
+	return token, token != ""
+}
+
+// validateToken checks the bearer token in the incoming metadata matches the expected token
+func validateToken(ctx context.Context, tokenSource TokenSource) error {
+	token := tokenSource()
+	if token == "" {
+		// Never accept an empty bearer token
+		return status.Errorf(codes.Unauthenticated, "no bearer token is configured")
+	}
+	presented, ok := BearerTokenFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "missing bearer token")
+	}
+	if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+		return status.Errorf(codes.Unauthenticated, "invalid bearer token")
+	}
+	return nil
+}
+
+// TokenAuthUnaryInterceptor rejects unary calls which do not present the current bearer token
+func TokenAuthUnaryInterceptor(token TokenSource) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateToken(ctx, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// TokenAuthStreamInterceptor rejects streaming calls which do not present the current bearer token
+func TokenAuthStreamInterceptor(token TokenSource) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := validateToken(ss.Context(), token); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
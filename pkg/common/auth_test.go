@@ -0,0 +1,50 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func callWithToken(t *testing.T, interceptor grpc.UnaryServerInterceptor, token string) error {
+	t.Helper()
+	ctx := context.Background()
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationMetadataKey, bearerPrefix+token))
+	}
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/Test/Method"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestTokenAuthUsesCurrentToken(t *testing.T) {
+	token := NewAtomicToken("old")
+	interceptor := TokenAuthUnaryInterceptor(token.Load)
+
+	if err := callWithToken(t, interceptor, "old"); err != nil {
+		t.Fatalf("expected the current token to be accepted, got %v", err)
+	}
+	token.Store("new")
+	if err := callWithToken(t, interceptor, "old"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected the replaced token to be rejected, got %v", err)
+	}
+	if err := callWithToken(t, interceptor, "new"); err != nil {
+		t.Errorf("expected the new token to be accepted, got %v", err)
+	}
+}
+
+func TestTokenAuthRejectsEmptyToken(t *testing.T) {
+	interceptor := TokenAuthUnaryInterceptor(StaticToken(""))
+	if err := callWithToken(t, interceptor, ""); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected a missing token to be rejected, got %v", err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, bearerPrefix))
+	if err := validateToken(ctx, StaticToken("")); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an empty token to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// categoryCodes maps error categories to the gRPC status code used to send them
+var categoryCodes = map[ErrorCategory]codes.Code{
+	ErrorCategory_UNKNOWN:       codes.Unknown,
+	ErrorCategory_INVALID_INPUT: codes.InvalidArgument,
+	ErrorCategory_NOT_FOUND:     codes.NotFound,
+	ErrorCategory_QUOTA:         codes.ResourceExhausted,
+	ErrorCategory_TRANSIENT:     codes.Unavailable,
+	ErrorCategory_PERMISSION:    codes.PermissionDenied,
+	ErrorCategory_INTERNAL:      codes.Internal,
+}
+
+// Code returns the gRPC status code for the error category
+func (c ErrorCategory) Code() codes.Code {
+	if code, ok := categoryCodes[c]; ok {
+		return code
+	}
+	return codes.Unknown
+}
+
+// categoryFromCode returns the error category for a gRPC status code
+func categoryFromCode(code codes.Code) ErrorCategory {
+	switch code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return ErrorCategory_INVALID_INPUT
+	case codes.NotFound:
+		return ErrorCategory_NOT_FOUND
+	case codes.ResourceExhausted:
+		return ErrorCategory_QUOTA
+	case codes.Unavailable, codes.Aborted, codes.DeadlineExceeded:
+		return ErrorCategory_TRANSIENT
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return ErrorCategory_PERMISSION
+	case codes.Internal, codes.DataLoss, codes.Unimplemented:
+		return ErrorCategory_INTERNAL
+	default:
+		return ErrorCategory_UNKNOWN
+	}
+}
+
+// NewProviderError returns a ProviderError in the category with a formatted message. Errors in the
+// TRANSIENT category are retryable by default
+func NewProviderError(category ErrorCategory, format string, args ...interface{}) *ProviderError {
+	return &ProviderError{
+		Category:  category,
+		Message:   fmt.Sprintf(format, args...),
+		Retryable: category == ErrorCategory_TRANSIENT,
+	}
+}
+
+// WithResourceKey sets the key of the resource the error relates to
+func (e *ProviderError) WithResourceKey(key string) *ProviderError {
+	e.ResourceKey = key
+	return e
+}
+
+// WithRetryable sets whether the operation may succeed if retried as-is
+func (e *ProviderError) WithRetryable(retryable bool) *ProviderError {
+	e.Retryable = retryable
+	return e
+}
+
+// WithDetail adds a provider-specific detail to the error
+func (e *ProviderError) WithDetail(key string, value string) *ProviderError {
+	if e.Details == nil {
+		e.Details = make(map[string]string)
+	}
+	e.Details[key] = value
+	return e
+}
+
+// Err returns a gRPC status error carrying the ProviderError as a detail, for returning from handlers
+func (e *ProviderError) Err() error {
+	st, err := status.New(e.Category.Code(), e.Message).WithDetails(e)
+	if err != nil {
+		// Fall back to the status without details rather than losing the error
+		return status.Error(e.Category.Code(), e.Message)
+	}
+	return st.Err()
+}
+
+// ProviderErrorFromError returns the ProviderError carried by a gRPC status error. Status errors
+// without a ProviderError detail are classified by their status code, and false is returned for
+// errors which are not gRPC status errors
+func ProviderErrorFromError(err error) (*ProviderError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if providerErr, ok := detail.(*ProviderError); ok {
+			return providerErr, true
+		}
+	}
+	category := categoryFromCode(st.Code())
+	return &ProviderError{
+		Category:  category,
+		Message:   st.Message(),
+		Retryable: category == ErrorCategory_TRANSIENT,
+	}, true
+}
+
+// IsRetryable returns whether the error is a ProviderError (or gRPC status error) which may succeed if retried
+func IsRetryable(err error) bool {
+	providerErr, ok := ProviderErrorFromError(err)
+	return ok && providerErr.Retryable
+}
+
+// IsCategory returns whether the error is a ProviderError (or gRPC status error) in the category
+func IsCategory(err error, category ErrorCategory) bool {
+	providerErr, ok := ProviderErrorFromError(err)
+	return ok && providerErr.Category == category
+}
@@ -0,0 +1,262 @@
+package common_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/cble"
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/metrics"
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+)
+
+// testPKI holds the paths of a CA and the server and client certificates it signed
+type testPKI struct {
+	caFile, serverCertFile, serverKeyFile, clientCertFile, clientKeyFile string
+}
+
+// newTestPKI writes a CA, a server certificate for localhost and a client certificate with the
+// common name to the directory
+func newTestPKI(t *testing.T, dir string, clientName string) *testPKI {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate CA key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create CA certificate: %v", err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("failed to parse CA certificate: %v", err)
+	}
+
+	pki := &testPKI{caFile: filepath.Join(dir, "ca.crt")}
+	writePEM(t, pki.caFile, "CERTIFICATE", caDER)
+	pki.serverCertFile, pki.serverKeyFile = issueCert(t, dir, "server", ca, caKey, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	pki.clientCertFile, pki.clientKeyFile = issueCert(t, dir, "client", ca, caKey, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: clientName},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return pki
+}
+
+// issueCert signs the certificate template with the CA and returns the paths of the cert and key
+func issueCert(t *testing.T, dir string, name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey, template *x509.Certificate) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate %s key: %v", name, err)
+	}
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("failed to create %s certificate: %v", name, err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal %s key: %v", name, err)
+	}
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+// peerName returns the common name of the verified peer in the context ("" if there is none)
+func peerName(ctx context.Context) string {
+	identity, ok := common.PeerIdentityFromContext(ctx)
+	if !ok || !identity.Verified {
+		return ""
+	}
+	return identity.Subject.CommonName
+}
+
+// waitForSocket blocks until the unix socket accepts connections
+func waitForSocket(t *testing.T, socket string) {
+	t.Helper()
+	for i := 0; ; i++ {
+		conn, err := net.Dial("unix", socket)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		if i == 500 {
+			t.Fatalf("socket %s was not ready: %v", socket, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// peerCBLEServer records the common name of the verified peer of each heartbeat
+type peerCBLEServer struct {
+	cble.DefaultCBLEServer
+	peers chan string
+}
+
+func (s peerCBLEServer) Heartbeat(ctx context.Context, request *cble.HeartbeatRequest) (*cble.HeartbeatReply, error) {
+	s.peers <- peerName(ctx)
+	return &cble.HeartbeatReply{Success: true}, nil
+}
+
+func TestCBLEMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	pki := newTestPKI(t, dir, "provider-client")
+	socket := filepath.Join(dir, "cble.sock")
+	server := peerCBLEServer{peers: make(chan string, 1)}
+	m := metrics.NewMetrics()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- cble.Serve(ctx, server, &cble.CBLEServerOptions{
+			TLS:      true,
+			CertFile: pki.serverCertFile,
+			KeyFile:  pki.serverKeyFile,
+			CAFile:   pki.caFile,
+			Socket:   socket,
+			Metrics:  m,
+		})
+	}()
+	defer func() {
+		cancel()
+		<-served
+	}()
+	waitForSocket(t, socket)
+
+	conn, err := cble.Connect(&cble.CBLEClientOptions{
+		TLS:      true,
+		CAFile:   pki.caFile,
+		CertFile: pki.clientCertFile,
+		KeyFile:  pki.clientKeyFile,
+		Socket:   socket,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	callCtx, callCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer callCancel()
+	client, err := cble.NewClient(callCtx, conn)
+	if err != nil {
+		t.Fatalf("failed to handshake over mTLS: %v", err)
+	}
+	if _, err := client.Heartbeat(callCtx, &cble.HeartbeatRequest{Id: "id"}); err != nil {
+		t.Fatalf("Heartbeat failed: %v", err)
+	}
+	if name := <-server.peers; name != "provider-client" {
+		t.Errorf("expected the verified peer provider-client, got %q", name)
+	}
+
+	// Without an authenticator, calls are labelled with the verified peer
+	families, err := m.Registry().Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "cble_grpc_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "provider" && label.GetValue() != "provider-client" {
+					t.Errorf("expected calls to be labelled with provider-client, got %q", label.GetValue())
+				}
+			}
+		}
+	}
+}
+
+// peerProviderServer records the common name of the verified peer of each Configure call
+type peerProviderServer struct {
+	provider.DefaultProviderServer
+	peers chan string
+}
+
+func (s peerProviderServer) Configure(ctx context.Context, request *provider.ConfigureRequest) (*provider.ConfigureReply, error) {
+	s.peers <- peerName(ctx)
+	return &provider.ConfigureReply{Success: true}, nil
+}
+
+func TestProviderMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	pki := newTestPKI(t, dir, "cble-client")
+	socket := filepath.Join(dir, "provider.sock")
+	server := peerProviderServer{peers: make(chan string, 1)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() {
+		served <- provider.ServeContext(ctx, server, &provider.ProviderServerOptions{
+			TLS:      true,
+			CertFile: pki.serverCertFile,
+			KeyFile:  pki.serverKeyFile,
+			CAFile:   pki.caFile,
+			Address:  socket,
+		})
+	}()
+	defer func() {
+		cancel()
+		<-served
+	}()
+
+	conn, err := provider.Connect(&provider.ProviderClientOptions{
+		TLS:      true,
+		CAFile:   pki.caFile,
+		CertFile: pki.clientCertFile,
+		KeyFile:  pki.clientKeyFile,
+		Address:  socket,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	callCtx, callCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer callCancel()
+	client, err := provider.NewClient(callCtx, conn)
+	if err != nil {
+		t.Fatalf("failed to handshake over mTLS: %v", err)
+	}
+	if _, err := client.Configure(callCtx, &provider.ConfigureRequest{}); err != nil {
+		t.Fatalf("Configure failed: %v", err)
+	}
+	if name := <-server.peers; name != "cble-client" {
+		t.Errorf("expected the verified peer cble-client, got %q", name)
+	}
+}
@@ -0,0 +1,143 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// NewServerTLSConfig returns the TLS config for a gRPC server. If a CA file is provided, client
+// certificates are verified against it using the client auth policy (defaulting to
+// tls.RequireAndVerifyClientCert if the policy is tls.NoClientCert)
+func NewServerTLSConfig(certFile string, keyFile string, caFile string, clientAuth tls.ClientAuthType) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("must provider a certificate and key file if using TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate and key: %v", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   clientAuth,
+	}
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		if config.ClientAuth == tls.NoClientCert {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return config, nil
+}
+
+// NewClientTLSConfig returns the TLS config for a gRPC client. If a certificate and key file are
+// provided, they are presented to the server as the client certificate
+func NewClientTLSConfig(caFile string, certFile string, keyFile string) (*tls.Config, error) {
+	if caFile == "" {
+		return nil, fmt.Errorf("CA file must be provided for TLS")
+	}
+	pool, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		RootCAs: pool,
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, fmt.Errorf("must provide both a certificate and key file for client authentication")
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate and key: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// loadCertPool loads a PEM encoded CA bundle
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("failed to parse any certificates from CA file %s", caFile)
+	}
+	return pool, nil
+}
+
+// PeerIdentity is the identity of a peer authenticated with a client certificate
+type PeerIdentity struct {
+	// The subject of the peer's certificate
+	Subject pkix.Name
+	// The peer's (leaf) certificate
+	Certificate *x509.Certificate
+	// Whether the certificate was verified against the CA bundle
+	Verified bool
+}
+
+type peerIdentityKey struct{}
+
+// PeerIdentityFromContext returns the identity of the peer which made the request (if the peer
+// presented a client certificate)
+func PeerIdentityFromContext(ctx context.Context) (*PeerIdentity, bool) {
+	identity, ok := ctx.Value(peerIdentityKey{}).(*PeerIdentity)
+	return identity, ok
+}
+
+// contextWithPeerIdentity returns a copy of the context carrying the peer identity (if any)
+func contextWithPeerIdentity(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ctx
+	}
+	identity := &PeerIdentity{}
+	if len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
+		identity.Certificate = tlsInfo.State.VerifiedChains[0][0]
+		identity.Verified = true
+	} else if len(tlsInfo.State.PeerCertificates) > 0 {
+		identity.Certificate = tlsInfo.State.PeerCertificates[0]
+	} else {
+		return ctx
+	}
+	identity.Subject = identity.Certificate.Subject
+	return context.WithValue(ctx, peerIdentityKey{}, identity)
+}
+
+// PeerIdentityUnaryInterceptor stores the peer identity in the context of unary calls
+func PeerIdentityUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(contextWithPeerIdentity(ctx), req)
+}
+
+// PeerIdentityStreamInterceptor stores the peer identity in the context of streaming calls
+func PeerIdentityStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &peerIdentityServerStream{ServerStream: ss, ctx: contextWithPeerIdentity(ss.Context())})
+}
+
+// peerIdentityServerStream overrides the context of a grpc.ServerStream
+type peerIdentityServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *peerIdentityServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,366 @@
+// Package launcher starts and supervises provider binaries on behalf of CBLE. The provider is passed
+// its socket ID, TLS material and auth token in its environment (see provider.ServerOptionsFromEnv),
+// is restarted with backoff if it crashes, and has its output captured into logrus
+package launcher
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	// The path to the provider binary
+	Path string
+	// Any arguments to pass to the provider binary
+	Args []string
+	// Any additional environment variables (in KEY=VALUE form) to pass to the provider
+	Env []string
+	// The socket ID the provider should listen on, defaults to a random ID
+	SocketID string
+	// The TLS material passed to the provider server
+	TLS      bool
+	CertFile string
+	KeyFile  string
+	// The CA bundle to verify the provider's certificate against when connecting
+	CAFile string
+	// The CA bundle the provider verifies client certificates against (enables mTLS, requires
+	// ClientCertFile and ClientKeyFile)
+	ClientCAFile string
+	// The client certificate and key presented to the provider when connecting (for mTLS)
+	ClientCertFile string
+	ClientKeyFile  string
+	// The name to verify the provider's certificate against, defaults to "localhost"
+	ServerName string
+	// The bearer token the provider should require on every call
+	AuthToken string
+	// How long to wait for the provider socket to appear after starting, defaults to 30 seconds
+	StartTimeout time.Duration
+	// How long to wait for the provider to exit after SIGTERM before killing it, defaults to 10 seconds
+	StopTimeout time.Duration
+	// The backoff before the first restart after a crash, defaults to 1 second
+	RestartBackoff time.Duration
+	// The maximum backoff between restarts, defaults to 1 minute
+	MaxRestartBackoff time.Duration
+	// The maximum number of restarts before giving up (0 restarts forever)
+	MaxRestarts int
+	// How long the provider must stay up for the restart backoff and count to be reset, defaults to
+	// 1 minute
+	RestartResetAfter time.Duration
+}
+
+// process is a single run of the provider binary
+type process struct {
+	cmd     *exec.Cmd
+	started time.Time
+	exited  chan struct{}
+	err     error
+}
+
+type Launcher struct {
+	config *Config
+	logger *logrus.Entry
+
+	mu       sync.Mutex
+	current  *process
+	stopping bool
+	stopped  chan struct{}
+}
+
+// New returns a Launcher for the provider binary. Call Start to run it
+func New(config *Config) *Launcher {
+	if config.SocketID == "" {
+		config.SocketID = uuid.New().String()
+	}
+	return &Launcher{
+		config: config,
+		logger: logrus.WithFields(logrus.Fields{
+			"component": "PROVIDER_LAUNCHER",
+			"provider":  config.Path,
+		}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// SocketID returns the socket ID the provider listens on
+func (l *Launcher) SocketID() string {
+	return l.config.SocketID
+}
+
+// ClientOptions returns the options to connect to the provider with provider.Connect
+func (l *Launcher) ClientOptions() *provider.ProviderClientOptions {
+	return &provider.ProviderClientOptions{
+		TLS:        l.config.TLS,
+		CAFile:     l.config.CAFile,
+		SocketID:   l.config.SocketID,
+		CertFile:   l.config.ClientCertFile,
+		KeyFile:    l.config.ClientKeyFile,
+		ServerName: l.config.ServerName,
+		AuthToken:  l.config.AuthToken,
+	}
+}
+
+// Done returns a channel which is closed once the provider is no longer supervised (after Stop, or
+// once the maximum number of restarts is reached)
+func (l *Launcher) Done() <-chan struct{} {
+	return l.stopped
+}
+
+// Start runs the provider and blocks until its socket is ready. The provider is then supervised in
+// the background until Stop is called or the context is cancelled
+func (l *Launcher) Start(ctx context.Context) error {
+	if l.config.ClientCAFile != "" && (l.config.ClientCertFile == "" || l.config.ClientKeyFile == "") {
+		return fmt.Errorf("client cert and key are required when the provider verifies client certificates")
+	}
+	proc, err := l.startProcess(ctx)
+	if err != nil {
+		return err
+	}
+
+	go l.supervise(ctx, proc)
+	go func() {
+		select {
+		case <-ctx.Done():
+			if err := l.Stop(); err != nil {
+				l.logger.Warnf("failed to stop provider: %v", err)
+			}
+		case <-l.stopped:
+		}
+	}()
+	return nil
+}
+
+// Stop sends SIGTERM to the provider, kills it if it does not exit within the stop timeout and stops
+// supervising it
+func (l *Launcher) Stop() error {
+	l.mu.Lock()
+	if l.stopping {
+		l.mu.Unlock()
+		<-l.stopped
+		return nil
+	}
+	l.stopping = true
+	proc := l.current
+	l.mu.Unlock()
+
+	defer func() {
+		l.removeSocket()
+		close(l.stopped)
+	}()
+	if proc == nil {
+		return nil
+	}
+
+	stopTimeout := l.config.StopTimeout
+	if stopTimeout <= 0 {
+		stopTimeout = 10 * time.Second
+	}
+	if err := proc.cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		// The process has already exited
+		return nil
+	}
+	select {
+	case <-proc.exited:
+		return nil
+	case <-time.After(stopTimeout):
+		l.logger.Warnf("Provider did not exit within %s, killing...", stopTimeout)
+		if err := proc.cmd.Process.Kill(); err != nil {
+			return fmt.Errorf("failed to kill provider: %v", err)
+		}
+		<-proc.exited
+		return nil
+	}
+}
+
+// startProcess runs the provider binary and waits for its socket to appear
+func (l *Launcher) startProcess(ctx context.Context) (*process, error) {
+	l.removeSocket()
+
+	cmd := exec.Command(l.config.Path, l.config.Args...)
+	cmd.Env = append(os.Environ(), l.config.Env...)
+	cmd.Env = append(cmd.Env,
+		provider.EnvSocketID+"="+l.config.SocketID,
+		provider.EnvTLS+"="+strconv.FormatBool(l.config.TLS),
+		provider.EnvCertFile+"="+l.config.CertFile,
+		provider.EnvKeyFile+"="+l.config.KeyFile,
+		provider.EnvCAFile+"="+l.config.ClientCAFile,
+		provider.EnvAuthToken+"="+l.config.AuthToken,
+	)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture provider stdout: %v", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture provider stderr: %v", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start provider: %v", err)
+	}
+	l.logger.Infof("Started provider (pid %d)", cmd.Process.Pid)
+
+	proc := &process{
+		cmd:     cmd,
+		started: time.Now(),
+		exited:  make(chan struct{}),
+	}
+	go func() {
+		// All output must be read before waiting on the process
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		go l.captureOutput(&wg, stdout, "stdout")
+		go l.captureOutput(&wg, stderr, "stderr")
+		wg.Wait()
+		proc.err = cmd.Wait()
+		close(proc.exited)
+	}()
+
+	l.mu.Lock()
+	if l.stopping {
+		// Stop was called while starting, so don't leave the process running unsupervised
+		l.mu.Unlock()
+		cmd.Process.Kill()
+		<-proc.exited
+		return nil, fmt.Errorf("launcher was stopped")
+	}
+	l.current = proc
+	l.mu.Unlock()
+
+	if err := l.waitForSocket(ctx, proc); err != nil {
+		cmd.Process.Kill()
+		<-proc.exited
+		return nil, err
+	}
+	return proc, nil
+}
+
+// maxOutputLine is the longest line of provider output which is logged
+const maxOutputLine = 1024 * 1024
+
+// captureOutput logs each line of the provider's output
+func (l *Launcher) captureOutput(wg *sync.WaitGroup, r io.Reader, stream string) {
+	defer wg.Done()
+	logger := l.logger.WithField("stream", stream)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxOutputLine)
+	for scanner.Scan() {
+		logger.Info(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		logger.Warnf("failed to read provider output, discarding the rest: %v", err)
+		// Keep draining the pipe so the provider never blocks writing to it
+		io.Copy(io.Discard, r)
+	}
+}
+
+// waitForSocket polls until the provider socket appears, the provider exits or the start timeout passes
+func (l *Launcher) waitForSocket(ctx context.Context, proc *process) error {
+	startTimeout := l.config.StartTimeout
+	if startTimeout <= 0 {
+		startTimeout = 30 * time.Second
+	}
+	timeout := time.After(startTimeout)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if _, err := os.Stat(provider.SocketPath(l.config.SocketID)); err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-proc.exited:
+			return fmt.Errorf("provider exited before its socket was ready: %v", proc.err)
+		case <-timeout:
+			return fmt.Errorf("provider socket was not ready within %s", startTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// supervise restarts the provider with backoff whenever it exits, until it is stopped. The backoff
+// and restart count are reset once the provider has stayed up for the reset period, so occasional
+// crashes of a long-running provider do not add up to the maximum restarts
+func (l *Launcher) supervise(ctx context.Context, proc *process) {
+	initialBackoff := l.config.RestartBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = time.Second
+	}
+	maxBackoff := l.config.MaxRestartBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = time.Minute
+	}
+	resetAfter := l.config.RestartResetAfter
+	if resetAfter <= 0 {
+		resetAfter = time.Minute
+	}
+
+	backoff := initialBackoff
+	restarts := 0
+	for {
+		<-proc.exited
+		if l.isStopping() {
+			return
+		}
+		l.logger.Errorf("Provider exited unexpectedly: %v", proc.err)
+		if time.Since(proc.started) >= resetAfter {
+			backoff = initialBackoff
+			restarts = 0
+		}
+
+		for {
+			if l.config.MaxRestarts > 0 && restarts >= l.config.MaxRestarts {
+				l.logger.Errorf("Provider reached the maximum of %d restarts, giving up", l.config.MaxRestarts)
+				l.Stop()
+				return
+			}
+			restarts++
+			l.logger.Warnf("Restarting provider in %s (restart %d)...", backoff, restarts)
+			select {
+			case <-time.After(backoff):
+			case <-l.stopped:
+				return
+			}
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+
+			if l.isStopping() {
+				return
+			}
+			var err error
+			proc, err = l.startProcess(ctx)
+			if err == nil {
+				break
+			}
+			l.logger.Errorf("Failed to restart provider: %v", err)
+		}
+	}
+}
+
+// isStopping returns whether Stop has been called
+func (l *Launcher) isStopping() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stopping
+}
+
+// removeSocket removes the provider socket file (if it exists)
+func (l *Launcher) removeSocket() {
+	path := provider.SocketPath(l.config.SocketID)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		l.logger.Warnf("failed to cleanup socket %s: %v", path, err)
+	}
+}
@@ -0,0 +1,38 @@
+package launcher
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCaptureOutputDrainsLongLines(t *testing.T) {
+	l := New(&Config{Path: "provider"})
+	r, w := io.Pipe()
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go l.captureOutput(&wg, r, "stdout")
+
+	written := make(chan error, 1)
+	go func() {
+		// A line longer than the scanner accepts followed by more output
+		_, err := w.Write(append(bytes.Repeat([]byte("a"), 2*maxOutputLine), '\n'))
+		if err == nil {
+			_, err = w.Write([]byte("after\n"))
+		}
+		w.Close()
+		written <- err
+	}()
+
+	select {
+	case err := <-written:
+		if err != nil {
+			t.Fatalf("failed to write output: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("writing output blocked after an overlong line")
+	}
+	wg.Wait()
+}
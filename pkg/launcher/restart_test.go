@@ -0,0 +1,169 @@
+package launcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+)
+
+const (
+	envHelperMode = "LAUNCHER_TEST_HELPER"
+	envHelperRuns = "LAUNCHER_TEST_RUNS"
+)
+
+// TestMain runs the test binary as a fake provider when launched by the tests
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(envHelperMode); mode != "" {
+		runHelper(mode)
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// runHelper records the run and then behaves as the mode says:
+//   - "crash" creates its socket and exits shortly after
+//   - "crash-once" does the same on the first run, and hangs without creating its socket afterwards
+func runHelper(mode string) {
+	f, err := os.OpenFile(os.Getenv(envHelperRuns), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		os.Exit(2)
+	}
+	f.WriteString("run\n")
+	f.Close()
+
+	if mode == "crash-once" && helperRuns(os.Getenv(envHelperRuns)) > 1 {
+		// Wait for the launcher to stop us (SIGTERM exits by default)
+		time.Sleep(time.Minute)
+		os.Exit(1)
+	}
+	socket, err := os.Create(provider.SocketPath(os.Getenv(provider.EnvSocketID)))
+	if err != nil {
+		os.Exit(2)
+	}
+	socket.Close()
+	time.Sleep(100 * time.Millisecond)
+	os.Exit(1)
+}
+
+// helperRuns returns the number of times the helper has been started
+func helperRuns(path string) int {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	return strings.Count(string(data), "\n")
+}
+
+// startHelper starts the test binary as a provider in the mode with the restart options of the
+// config and returns its launcher
+func startHelper(t *testing.T, mode string, config *Config) (*Launcher, string) {
+	t.Helper()
+	runs := filepath.Join(t.TempDir(), "runs")
+	config.Path = os.Args[0]
+	config.Env = []string{envHelperMode + "=" + mode, envHelperRuns + "=" + runs}
+	config.StartTimeout = 10 * time.Second
+	config.StopTimeout = 5 * time.Second
+	l := New(config)
+	if err := l.Start(context.Background()); err != nil {
+		t.Fatalf("failed to start provider: %v", err)
+	}
+	return l, runs
+}
+
+// stopLauncher stops the launcher and checks it stops supervising the provider promptly
+func stopLauncher(t *testing.T, l *Launcher) {
+	t.Helper()
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- l.Stop()
+	}()
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Errorf("Stop failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop did not return")
+	}
+	select {
+	case <-l.Done():
+	default:
+		t.Errorf("expected Done to be closed after Stop")
+	}
+	if _, err := os.Stat(provider.SocketPath(l.SocketID())); !os.IsNotExist(err) {
+		t.Errorf("expected the socket to be removed, got %v", err)
+	}
+}
+
+func TestStopDuringRestartBackoff(t *testing.T) {
+	l, runs := startHelper(t, "crash", &Config{RestartBackoff: time.Minute})
+	// Let the provider crash so the launcher waits to restart it
+	time.Sleep(500 * time.Millisecond)
+	stopLauncher(t, l)
+
+	time.Sleep(200 * time.Millisecond)
+	if n := helperRuns(runs); n != 1 {
+		t.Errorf("expected the provider not to be restarted after Stop, got %d runs", n)
+	}
+}
+
+func TestStopWhileRestarting(t *testing.T) {
+	l, runs := startHelper(t, "crash-once", &Config{RestartBackoff: 10 * time.Millisecond})
+	// Wait until the restarted provider is waiting for its socket
+	deadline := time.Now().Add(5 * time.Second)
+	for helperRuns(runs) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("provider was not restarted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	stopLauncher(t, l)
+
+	time.Sleep(200 * time.Millisecond)
+	if n := helperRuns(runs); n != 2 {
+		t.Errorf("expected the provider not to be restarted after Stop, got %d runs", n)
+	}
+}
+
+func TestRestartsResetAfterStableRun(t *testing.T) {
+	// Each run stays up for over 100ms, which is longer than the reset period
+	l, runs := startHelper(t, "crash", &Config{
+		RestartBackoff:    10 * time.Millisecond,
+		MaxRestarts:       2,
+		RestartResetAfter: 50 * time.Millisecond,
+	})
+	deadline := time.Now().Add(10 * time.Second)
+	for helperRuns(runs) < 5 {
+		select {
+		case <-l.Done():
+			t.Fatalf("expected the launcher to keep restarting stable runs, gave up after %d runs", helperRuns(runs))
+		default:
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("provider was not restarted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	stopLauncher(t, l)
+}
+
+func TestRestartsGiveUpWithoutStableRun(t *testing.T) {
+	l, runs := startHelper(t, "crash", &Config{
+		RestartBackoff:    10 * time.Millisecond,
+		MaxRestarts:       2,
+		RestartResetAfter: time.Minute,
+	})
+	select {
+	case <-l.Done():
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the launcher to give up after the maximum restarts")
+	}
+	if n := helperRuns(runs); n != 3 {
+		t.Errorf("expected the first run and 2 restarts, got %d runs", n)
+	}
+}
@@ -0,0 +1,255 @@
+// Package metrics records Prometheus metrics for CBLE and provider gRPC traffic. Server interceptors
+// are installed by provider.Serve and cble.Serve when metrics are set in their options, and client
+// interceptors can be passed to provider.Connect/cble.Connect using the DialOptions
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type Metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+// NewMetrics returns Metrics registered with a new Prometheus registry (along with the Go and
+// process collectors)
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "cble",
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Total number of completed gRPC calls.",
+		}, []string{"provider", "method", "success"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "cble",
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of completed gRPC calls.",
+			// Deploys can take a long time, so extend the buckets up to ~20 minutes
+			Buckets: prometheus.ExponentialBuckets(0.005, 4, 10),
+		}, []string{"provider", "method", "success"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "cble",
+			Subsystem: "grpc",
+			Name:      "requests_in_flight",
+			Help:      "Number of gRPC calls currently in-flight.",
+		}, []string{"provider", "method"}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.duration,
+		m.inFlight,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Registry returns the Prometheus registry the metrics are registered with
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler returns the HTTP handler which serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// start records a call as in-flight and returns the function to call once it has completed
+func (m *Metrics) start(provider string, fullMethod string) func(succeeded bool) {
+	method := path.Base(fullMethod)
+	start := time.Now()
+	m.inFlight.WithLabelValues(provider, method).Inc()
+	return func(succeeded bool) {
+		m.inFlight.WithLabelValues(provider, method).Dec()
+		success := strconv.FormatBool(succeeded)
+		m.requests.WithLabelValues(provider, method, success).Inc()
+		m.duration.WithLabelValues(provider, method, success).Observe(time.Since(start).Seconds())
+	}
+}
+
+// isSuccess returns whether the call succeeded, using the success flag of the reply if it has one
+func isSuccess(reply interface{}, err error) bool {
+	if err != nil {
+		return false
+	}
+	if reply, ok := reply.(interface{ GetSuccess() bool }); ok {
+		return reply.GetSuccess()
+	}
+	return true
+}
+
+// streamResult tracks the success flag of the final result sent on a stream (e.g. the Result of
+// the last DeployResourceProgress)
+type streamResult struct {
+	mu sync.Mutex
+	// Whether the stream's messages have a result field
+	hasResult bool
+	// The success flag of the last result seen (nil if none)
+	success *bool
+}
+
+// observe records the result carried by a message sent on the stream (if any)
+func (r *streamResult) observe(msg interface{}) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	message := m.ProtoReflect()
+	field := message.Descriptor().Fields().ByName("result")
+	if field == nil || field.Message() == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hasResult = true
+	if !message.Has(field) {
+		return
+	}
+	result := message.Get(field).Message()
+	successField := result.Descriptor().Fields().ByName("success")
+	if successField == nil {
+		return
+	}
+	success := result.Get(successField).Bool()
+	r.success = &success
+}
+
+// isSuccess returns whether the stream succeeded. Streams whose messages have a result field must
+// end with a successful result
+func (r *streamResult) isSuccess(err error) bool {
+	if err != nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.hasResult {
+		return r.success != nil && *r.success
+	}
+	return true
+}
+
+// LabelFunc returns the provider label of a server call. It must only return a bounded set of values
+// (e.g. authenticated provider names), as every value creates new metric series
+type LabelFunc func(ctx context.Context, req interface{}) string
+
+// UnaryServerInterceptor records metrics for unary calls labelled with the provider name
+func (m *Metrics) UnaryServerInterceptor(provider string) grpc.UnaryServerInterceptor {
+	return m.UnaryServerInterceptorFunc(func(context.Context, interface{}) string {
+		return provider
+	})
+}
+
+// UnaryServerInterceptorFunc records metrics for unary calls labelled with the provider returned by
+// the label function
+func (m *Metrics) UnaryServerInterceptorFunc(label LabelFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (reply interface{}, err error) {
+		done := m.start(label(ctx, req), info.FullMethod)
+		defer func() {
+			done(isSuccess(reply, err))
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor records metrics for streaming calls labelled with the provider name
+func (m *Metrics) StreamServerInterceptor(provider string) grpc.StreamServerInterceptor {
+	return m.StreamServerInterceptorFunc(func(context.Context, interface{}) string {
+		return provider
+	})
+}
+
+// StreamServerInterceptorFunc records metrics for streaming calls labelled with the provider returned
+// by the label function (called without a request). The call succeeds if the stream ends without
+// an error and with a successful result (if its messages carry one)
+func (m *Metrics) StreamServerInterceptorFunc(label LabelFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		done := m.start(label(ss.Context(), nil), info.FullMethod)
+		stream := &monitoredServerStream{ServerStream: ss}
+		defer func() {
+			done(stream.result.isSuccess(err))
+		}()
+		return handler(srv, stream)
+	}
+}
+
+// monitoredServerStream records the result of the messages sent on the stream
+type monitoredServerStream struct {
+	grpc.ServerStream
+	result streamResult
+}
+
+func (s *monitoredServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.result.observe(m)
+	return nil
+}
+
+// UnaryClientInterceptor records metrics for unary calls made to the provider
+func (m *Metrics) UnaryClientInterceptor(provider string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+		done := m.start(provider, method)
+		defer func() {
+			done(isSuccess(reply, err))
+		}()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor records metrics for streaming calls made to the provider. The call is
+// recorded once the stream has been fully received or fails, and succeeds if it ends with a
+// successful result (if its messages carry one)
+func (m *Metrics) StreamClientInterceptor(provider string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done := m.start(provider, method)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(false)
+			return nil, err
+		}
+		return &monitoredClientStream{ClientStream: cs, done: done}, nil
+	}
+}
+
+// monitoredClientStream records the call once the stream has ended
+type monitoredClientStream struct {
+	grpc.ClientStream
+	done   func(succeeded bool)
+	once   sync.Once
+	result streamResult
+}
+
+func (s *monitoredClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil {
+		s.result.observe(m)
+		return nil
+	}
+	s.once.Do(func() {
+		if err == io.EOF {
+			s.done(s.result.isSuccess(nil))
+		} else {
+			s.done(false)
+		}
+	})
+	return err
+}
@@ -0,0 +1,68 @@
+package metrics_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/metrics"
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+)
+
+type streamingProvider struct {
+	provider.DefaultProviderServer
+}
+
+func (streamingProvider) DeployResourceStream(request *provider.DeployResourceRequest, stream provider.Provider_DeployResourceStreamServer) error {
+	return provider.StreamDeployResource(request, stream, func(ctx context.Context, request *provider.DeployResourceRequest) (*provider.DeployResourceReply, error) {
+		errStr := "failed"
+		return &provider.DeployResourceReply{Success: request.Resource.Key == "ok", Error: &errStr}, nil
+	})
+}
+
+func TestStreamServerInterceptorUsesResult(t *testing.T) {
+	m := metrics.NewMetrics()
+	client := providertest.NewClient(t, streamingProvider{}, &providertest.Options{
+		Server: &provider.ProviderServerOptions{Metrics: m, ProviderName: "test"},
+	})
+
+	for _, key := range []string{"ok", "fail"} {
+		stream, err := client.DeployResourceStream(context.Background(), &provider.DeployResourceRequest{
+			Resource: &provider.Resource{Key: key},
+		})
+		if err != nil {
+			t.Fatalf("DeployResourceStream failed: %v", err)
+		}
+		for {
+			if _, err := stream.Recv(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("failed to receive progress: %v", err)
+			}
+		}
+	}
+
+	requests, err := m.Registry().Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	counts := map[string]float64{}
+	for _, family := range requests {
+		if family.GetName() != "cble_grpc_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["method"] == "DeployResourceStream" {
+				counts[labels["success"]] += metric.GetCounter().GetValue()
+			}
+		}
+	}
+	if counts["true"] != 1 || counts["false"] != 1 {
+		t.Errorf("expected 1 successful and 1 failed stream, got %v", counts)
+	}
+}
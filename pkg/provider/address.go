@@ -0,0 +1,29 @@
+package provider
+
+import "fmt"
+
+// SocketPath returns the path of the unix socket for the given socket ID
+func SocketPath(socketID string) string {
+	return fmt.Sprintf("/tmp/cble-provider-grpc-%s", socketID)
+}
+
+// resolveAddress returns the network and address to listen on/dial. The network defaults to
+// "unix" and the unix address defaults to the socket path of the socket ID
+func resolveAddress(network string, address string, socketID string) (string, string, error) {
+	if network == "" {
+		network = "unix"
+	}
+	switch network {
+	case "unix":
+		if address == "" {
+			address = SocketPath(socketID)
+		}
+	case "tcp", "tcp4", "tcp6":
+		if address == "" {
+			return "", "", fmt.Errorf("address must be provided for network %s", network)
+		}
+	default:
+		return "", "", fmt.Errorf("unsupported network %s", network)
+	}
+	return network, address, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/tracing"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -17,29 +18,64 @@ type ProviderClientOptions struct {
 	TLS      bool
 	CAFile   string
 	SocketID string
+	// The client certificate and key to present to the server (for mTLS)
+	CertFile string
+	KeyFile  string
+	// The name to verify the server certificate against, defaults to "localhost" for unix sockets
+	// (and the host of the address otherwise)
+	ServerName string
+	// The bearer token (from the RegistrationReply) to present on every call
+	AuthToken string
+	// The network to dial ("unix", "tcp", "tcp4" or "tcp6"), defaults to "unix"
+	Network string
+	// The address to dial, defaults to the socket path of SocketID for "unix"
+	Address string
+	// Retries failed unary calls according to the policy if set
+	Retry *RetryPolicy
+	// Any additional options to pass to grpc.Dial (e.g. client interceptors)
+	DialOptions []grpc.DialOption
 }
 
 // Connect returns a ProviderServer gRPC connection to the Provider gRPC server for use with the gRPC client
 func Connect(options *ProviderClientOptions) (*grpc.ClientConn, error) {
+	network, address, err := resolveAddress(options.Network, options.Address, options.SocketID)
+	if err != nil {
+		return nil, err
+	}
 	var opts []grpc.DialOption = []grpc.DialOption{
 		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
-			return net.Dial("unix", addr)
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, addr)
 		}),
 	}
 	if options.TLS {
-		if options.CAFile == "" {
-			return nil, fmt.Errorf("CA file must be provided for TLS")
-		}
-		creds, err := credentials.NewClientTLSFromFile(options.CAFile, "")
+		tlsConfig, err := common.NewClientTLSConfig(options.CAFile, options.CertFile, options.KeyFile)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create TLS credentials: %v", err)
 		}
-		opts = append(opts, grpc.WithTransportCredentials(creds))
+		tlsConfig.ServerName = options.ServerName
+		if tlsConfig.ServerName == "" && network == "unix" {
+			// The dial target of a unix socket is its path, which is never a certificate name
+			tlsConfig.ServerName = "localhost"
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
+	if options.AuthToken != "" {
+		// Bearer tokens would be sent in cleartext over the network without TLS
+		if network != "unix" && !options.TLS {
+			return nil, fmt.Errorf("auth token requires TLS on network %s", network)
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(common.TokenCredentials{Token: options.AuthToken}))
+	}
+
+	if options.Retry != nil {
+		opts = append(opts, WithRetry(options.Retry))
+	}
+	opts = append(opts, options.DialOptions...)
 
-	conn, err := grpc.Dial(fmt.Sprintf("/tmp/cble-provider-grpc-%s", options.SocketID), opts...)
+	conn, err := grpc.Dial(address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("fail to dial: %v", err)
 	}
@@ -47,8 +83,11 @@ func Connect(options *ProviderClientOptions) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+// NewClient returns a ProviderClient once the handshake with the provider succeeds. Calls made with the
+// client propagate the OpenTelemetry trace context of their context to the provider. Calls are only
+// retried if the connection was dialed with retries (see WithRetry)
 func NewClient(ctx context.Context, conn grpc.ClientConnInterface) (ProviderClient, error) {
-	client := NewProviderClient(conn)
+	client := NewProviderClient(tracing.ClientConn(conn, requestAttributes))
 
 	// Create a context with a 30 seconds timeout. If doesn't handshake in
 	//   30 seconds (server never came up), something is wrong
@@ -0,0 +1,23 @@
+package provider
+
+import "testing"
+
+func TestConnectRefusesCleartextToken(t *testing.T) {
+	_, err := Connect(&ProviderClientOptions{
+		Network:   "tcp",
+		Address:   "127.0.0.1:0",
+		AuthToken: "token",
+	})
+	if err == nil {
+		t.Fatal("expected Connect to refuse sending a token over TCP without TLS")
+	}
+
+	conn, err := Connect(&ProviderClientOptions{
+		SocketID:  "test",
+		AuthToken: "token",
+	})
+	if err != nil {
+		t.Fatalf("expected Connect to allow a token over a unix socket, got %v", err)
+	}
+	conn.Close()
+}
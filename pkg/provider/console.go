@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	sync "sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// consoleCloseTimeout is how long Proxy waits for the client to stop sending once the session is closed
+const consoleCloseTimeout = 5 * time.Second
+
+// consoleFrames reads and writes console bytes as frames over either side of a ConsoleSession stream
+type consoleFrames struct {
+	send     func(*ConsoleFrame) error
+	recv     func() (*ConsoleFrame, error)
+	onResize func(*ConsoleResize)
+
+	sendMu sync.Mutex
+	buf    []byte
+	err    error
+}
+
+// read returns buffered console bytes, receiving frames until data is available or the session ends
+func (f *consoleFrames) read(p []byte) (int, error) {
+	for len(f.buf) == 0 {
+		if f.err != nil {
+			return 0, f.err
+		}
+		frame, err := f.recv()
+		if err != nil {
+			f.err = err
+			continue
+		}
+		switch frame := frame.Frame.(type) {
+		case *ConsoleFrame_Data:
+			f.buf = frame.Data
+		case *ConsoleFrame_Resize:
+			if f.onResize != nil {
+				f.onResize(frame.Resize)
+			}
+		case *ConsoleFrame_Close:
+			if frame.Close.Error != nil {
+				f.err = fmt.Errorf("console closed: %s", *frame.Close.Error)
+			} else {
+				f.err = io.EOF
+			}
+		case *ConsoleFrame_Open:
+			f.err = fmt.Errorf("unexpected open frame in console session")
+		}
+	}
+	n := copy(p, f.buf)
+	f.buf = f.buf[n:]
+	return n, nil
+}
+
+// write sends the console bytes as a data frame
+func (f *consoleFrames) write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := f.sendFrame(&ConsoleFrame{Frame: &ConsoleFrame_Data{Data: data}}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// sendFrame sends a frame, as gRPC streams do not support concurrent sends
+func (f *consoleFrames) sendFrame(frame *ConsoleFrame) error {
+	f.sendMu.Lock()
+	defer f.sendMu.Unlock()
+	return f.send(frame)
+}
+
+// closeFrame returns a close frame containing the error (if any)
+func closeFrame(err error) *ConsoleFrame {
+	frame := &ConsoleFrame_Close{Close: &ConsoleClose{}}
+	if err != nil {
+		errStr := err.Error()
+		frame.Close.Error = &errStr
+	}
+	return &ConsoleFrame{Frame: frame}
+}
+
+// ConsoleStreamer is the provider side of a ConsoleSession, exposing the session as an io.ReadWriteCloser
+type ConsoleStreamer struct {
+	frames consoleFrames
+	open   *ConsoleOpen
+	ctx    context.Context
+}
+
+var _ io.ReadWriteCloser = (*ConsoleStreamer)(nil)
+
+// NewConsoleStreamer receives the open frame from the client and returns a ConsoleStreamer for the session
+func NewConsoleStreamer(stream Provider_ConsoleSessionServer) (*ConsoleStreamer, error) {
+	frame, err := stream.Recv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive open frame: %v", err)
+	}
+	open := frame.GetOpen()
+	if open == nil {
+		return nil, fmt.Errorf("first frame of console session must be an open frame")
+	}
+	return &ConsoleStreamer{
+		frames: consoleFrames{
+			send: stream.Send,
+			recv: stream.Recv,
+		},
+		open: open,
+		ctx:  stream.Context(),
+	}, nil
+}
+
+// Open returns the open frame sent by the client (which resource and type of console to open)
+func (s *ConsoleStreamer) Open() *ConsoleOpen {
+	return s.open
+}
+
+// OnResize sets the function called when the client resizes the console. Resizes are
+// handled while reading, so this must be set before the first call to Read
+func (s *ConsoleStreamer) OnResize(onResize func(cols uint32, rows uint32)) {
+	s.frames.onResize = func(resize *ConsoleResize) {
+		onResize(resize.Cols, resize.Rows)
+	}
+}
+
+// Read reads console bytes sent by the client
+func (s *ConsoleStreamer) Read(p []byte) (int, error) {
+	return s.frames.read(p)
+}
+
+// Write sends console bytes to the client
+func (s *ConsoleStreamer) Write(p []byte) (int, error) {
+	return s.frames.write(p)
+}
+
+// Close tells the client the console session has ended
+func (s *ConsoleStreamer) Close() error {
+	return s.CloseWithError(nil)
+}
+
+// CloseWithError tells the client the console session has ended due to an error
+func (s *ConsoleStreamer) CloseWithError(err error) error {
+	return s.frames.sendFrame(closeFrame(err))
+}
+
+// Proxy copies console bytes between the session and the backend console connection until either
+// side is closed, then closes both. It only returns once nothing is sending on the session, so the
+// handler can return straight after. Any error other than the session ending is returned
+func (s *ConsoleStreamer) Proxy(conn io.ReadWriteCloser) error {
+	fromSession := make(chan error, 1)
+	toSession := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(conn, s)
+		fromSession <- err
+	}()
+	go func() {
+		_, err := io.Copy(s, conn)
+		toSession <- err
+	}()
+
+	var err error
+	select {
+	case err = <-fromSession:
+		fromSession = nil
+	case err = <-toSession:
+		toSession = nil
+	}
+	// Closing the conn ends the copy to the session, which must finish before the handler returns as
+	// gRPC does not allow sends after that
+	conn.Close()
+	if toSession != nil {
+		<-toSession
+	}
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	if closeErr := s.CloseWithError(err); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	// The copy from the session ends once the client stops sending in reply to the close frame
+	if fromSession != nil {
+		select {
+		case <-fromSession:
+		case <-s.ctx.Done():
+		case <-time.After(consoleCloseTimeout):
+			logrus.WithField("component", "PROVIDER_GRPC_SERVER").Warnf("Console client did not stop sending within %s of the session closing", consoleCloseTimeout)
+		}
+	}
+	return err
+}
+
+// ConsoleConn is the CBLE side of a ConsoleSession, exposing the session as an io.ReadWriteCloser
+type ConsoleConn struct {
+	frames consoleFrames
+	stream Provider_ConsoleSessionClient
+	// Whether the stream has been half-closed (guarded by frames.sendMu)
+	sendClosed bool
+}
+
+var _ io.ReadWriteCloser = (*ConsoleConn)(nil)
+
+// OpenConsole starts a ConsoleSession with the provider and sends the open frame
+func OpenConsole(ctx context.Context, client ProviderClient, open *ConsoleOpen, opts ...grpc.CallOption) (*ConsoleConn, error) {
+	stream, err := client.ConsoleSession(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start console session: %v", err)
+	}
+	conn := &ConsoleConn{
+		frames: consoleFrames{
+			send: stream.Send,
+			recv: stream.Recv,
+		},
+		stream: stream,
+	}
+	if err := conn.frames.sendFrame(&ConsoleFrame{Frame: &ConsoleFrame_Open{Open: open}}); err != nil {
+		return nil, fmt.Errorf("failed to send open frame: %v", err)
+	}
+	return conn, nil
+}
+
+// Read reads console bytes sent by the provider
+func (c *ConsoleConn) Read(p []byte) (int, error) {
+	n, err := c.frames.read(p)
+	if err != nil {
+		// The session has ended, so stop sending too to let the provider finish
+		c.closeSend()
+	}
+	return n, err
+}
+
+// Write sends console bytes to the provider
+func (c *ConsoleConn) Write(p []byte) (int, error) {
+	return c.frames.write(p)
+}
+
+// Resize tells the provider the console has been resized
+func (c *ConsoleConn) Resize(cols uint32, rows uint32) error {
+	return c.frames.sendFrame(&ConsoleFrame{Frame: &ConsoleFrame_Resize{Resize: &ConsoleResize{
+		Cols: cols,
+		Rows: rows,
+	}}})
+}
+
+// Close tells the provider the console session has ended
+func (c *ConsoleConn) Close() error {
+	c.frames.sendMu.Lock()
+	sendClosed := c.sendClosed
+	c.frames.sendMu.Unlock()
+	if sendClosed {
+		return nil
+	}
+	if err := c.frames.sendFrame(closeFrame(nil)); err != nil {
+		return err
+	}
+	return c.closeSend()
+}
+
+// closeSend half-closes the stream (once)
+func (c *ConsoleConn) closeSend() error {
+	c.frames.sendMu.Lock()
+	defer c.frames.sendMu.Unlock()
+	if c.sendClosed {
+		return nil
+	}
+	c.sendClosed = true
+	return c.stream.CloseSend()
+}
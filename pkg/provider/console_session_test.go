@@ -0,0 +1,65 @@
+package provider_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+)
+
+// consoleProvider proxies console sessions to a backend which writes a banner and disconnects
+type consoleProvider struct {
+	provider.DefaultProviderServer
+	returned chan error
+}
+
+func (p consoleProvider) ConsoleSession(stream provider.Provider_ConsoleSessionServer) error {
+	streamer, err := provider.NewConsoleStreamer(stream)
+	if err != nil {
+		return err
+	}
+	backend, conn := net.Pipe()
+	go func() {
+		backend.Write([]byte("banner"))
+		backend.Close()
+	}()
+	err = streamer.Proxy(conn)
+	p.returned <- err
+	return err
+}
+
+func TestConsoleSessionEndedByProvider(t *testing.T) {
+	server := consoleProvider{returned: make(chan error, 1)}
+	client := providertest.NewClient(t, server, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := provider.OpenConsole(ctx, client, &provider.ConsoleOpen{Resource: &provider.Resource{Key: "vm"}})
+	if err != nil {
+		t.Fatalf("OpenConsole failed: %v", err)
+	}
+	data, err := io.ReadAll(conn)
+	if err != nil {
+		t.Fatalf("failed to read console: %v", err)
+	}
+	if string(data) != "banner" {
+		t.Errorf("expected to read %q, got %q", "banner", data)
+	}
+
+	// Reading the end of the session stops the client sending, so the provider finishes promptly
+	select {
+	case err := <-server.returned:
+		if err != nil {
+			t.Errorf("Proxy failed: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("provider did not finish the session")
+	}
+	if err := conn.Close(); err != nil {
+		t.Errorf("Close after the session ended failed: %v", err)
+	}
+}
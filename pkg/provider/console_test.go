@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// framesFrom returns consoleFrames which receive the frames in order, then fail with io.EOF
+func framesFrom(frames ...*ConsoleFrame) *consoleFrames {
+	return &consoleFrames{
+		recv: func() (*ConsoleFrame, error) {
+			if len(frames) == 0 {
+				return nil, io.EOF
+			}
+			frame := frames[0]
+			frames = frames[1:]
+			return frame, nil
+		},
+	}
+}
+
+func dataFrame(data string) *ConsoleFrame {
+	return &ConsoleFrame{Frame: &ConsoleFrame_Data{Data: []byte(data)}}
+}
+
+func TestConsoleFramesRead(t *testing.T) {
+	var resizes []*ConsoleResize
+	frames := framesFrom(
+		dataFrame("hello"),
+		&ConsoleFrame{Frame: &ConsoleFrame_Resize{Resize: &ConsoleResize{Cols: 80, Rows: 24}}},
+		dataFrame(" world"),
+		closeFrame(nil),
+	)
+	frames.onResize = func(resize *ConsoleResize) {
+		resizes = append(resizes, resize)
+	}
+
+	// Read in small chunks so data is buffered across reads
+	var read []byte
+	p := make([]byte, 3)
+	for {
+		n, err := frames.read(p)
+		read = append(read, p[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read failed: %v", err)
+		}
+	}
+	if string(read) != "hello world" {
+		t.Errorf("expected to read %q, got %q", "hello world", read)
+	}
+	if len(resizes) != 1 || resizes[0].Cols != 80 || resizes[0].Rows != 24 {
+		t.Errorf("expected a single 80x24 resize, got %v", resizes)
+	}
+	// The session stays closed
+	if _, err := frames.read(p); err != io.EOF {
+		t.Errorf("expected further reads to return io.EOF, got %v", err)
+	}
+}
+
+func TestConsoleFramesReadCloseWithError(t *testing.T) {
+	frames := framesFrom(dataFrame("data"), closeFrame(errors.New("backend gone")))
+	p := make([]byte, 16)
+	if n, err := frames.read(p); err != nil || string(p[:n]) != "data" {
+		t.Fatalf("expected to read the data before the close, got %q (%v)", p[:n], err)
+	}
+	_, err := frames.read(p)
+	if err == nil || err == io.EOF || err.Error() != "console closed: backend gone" {
+		t.Errorf("expected the close error, got %v", err)
+	}
+}
+
+func TestConsoleFramesReadErrors(t *testing.T) {
+	recvErr := errors.New("stream broken")
+	frames := &consoleFrames{
+		recv: func() (*ConsoleFrame, error) {
+			return nil, recvErr
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := frames.read(make([]byte, 1)); err != recvErr {
+			t.Errorf("expected the receive error, got %v", err)
+		}
+	}
+
+	frames = framesFrom(&ConsoleFrame{Frame: &ConsoleFrame_Open{Open: &ConsoleOpen{}}})
+	if _, err := frames.read(make([]byte, 1)); err == nil || err == io.EOF {
+		t.Errorf("expected an error for an unexpected open frame, got %v", err)
+	}
+}
+
+func TestCloseFrame(t *testing.T) {
+	if frame := closeFrame(nil).GetClose(); frame == nil || frame.Error != nil {
+		t.Errorf("expected a close frame without an error, got %v", frame)
+	}
+	if frame := closeFrame(errors.New("failed")).GetClose(); frame.GetError() != "failed" {
+		t.Errorf("expected a close frame with the error, got %v", frame)
+	}
+}
+
+// fakeConsoleSession is the server side of a console session driven by the test as the client. Frames
+// sent by the client are queued on recvCh, which the client closes to half-close the session
+type fakeConsoleSession struct {
+	grpc.ServerStream
+	recvCh   chan *ConsoleFrame
+	sent     chan *ConsoleFrame
+	returned int32
+	late     int32
+}
+
+func newFakeConsoleSession() *fakeConsoleSession {
+	return &fakeConsoleSession{
+		recvCh: make(chan *ConsoleFrame, 16),
+		sent:   make(chan *ConsoleFrame, 1024),
+	}
+}
+
+func (s *fakeConsoleSession) Send(frame *ConsoleFrame) error {
+	if atomic.LoadInt32(&s.returned) == 1 {
+		// gRPC does not allow sends once the handler has returned
+		atomic.AddInt32(&s.late, 1)
+	}
+	select {
+	case s.sent <- frame:
+	default:
+	}
+	// Like ConsoleConn, the client stops sending once the provider closes the session
+	if frame.GetClose() != nil {
+		close(s.recvCh)
+	}
+	return nil
+}
+
+func (s *fakeConsoleSession) Recv() (*ConsoleFrame, error) {
+	frame, ok := <-s.recvCh
+	if !ok {
+		return nil, io.EOF
+	}
+	return frame, nil
+}
+
+func (s *fakeConsoleSession) Context() context.Context {
+	return context.Background()
+}
+
+// proxySession opens a console session on the fake and proxies it to one end of a pipe, returning
+// the other end and a channel receiving the result of Proxy
+func proxySession(t *testing.T, session *fakeConsoleSession) (net.Conn, chan error) {
+	t.Helper()
+	session.recvCh <- &ConsoleFrame{Frame: &ConsoleFrame_Open{Open: &ConsoleOpen{}}}
+	streamer, err := NewConsoleStreamer(session)
+	if err != nil {
+		t.Fatalf("NewConsoleStreamer failed: %v", err)
+	}
+	backend, conn := net.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := streamer.Proxy(conn)
+		atomic.StoreInt32(&session.returned, 1)
+		done <- err
+	}()
+	return backend, done
+}
+
+func waitForProxy(t *testing.T, session *fakeConsoleSession, done chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Proxy failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Proxy did not return")
+	}
+	// Give any straggling copy a chance to send
+	time.Sleep(50 * time.Millisecond)
+	if late := atomic.LoadInt32(&session.late); late != 0 {
+		t.Errorf("expected no sends after Proxy returned, got %d", late)
+	}
+}
+
+func TestConsoleStreamerProxyBackendClosed(t *testing.T) {
+	session := newFakeConsoleSession()
+	backend, done := proxySession(t, session)
+
+	backend.Write([]byte("login: "))
+	backend.Close()
+	waitForProxy(t, session, done)
+
+	var data []byte
+	var closed bool
+	for len(session.sent) > 0 {
+		frame := <-session.sent
+		data = append(data, frame.GetData()...)
+		closed = closed || frame.GetClose() != nil
+	}
+	if string(data) != "login: " || !closed {
+		t.Errorf("expected the backend output followed by a close frame, got %q (closed %t)", data, closed)
+	}
+}
+
+func TestConsoleStreamerProxyClientClosed(t *testing.T) {
+	session := newFakeConsoleSession()
+	backend, done := proxySession(t, session)
+
+	// The backend keeps writing while the client closes the session
+	go func() {
+		for {
+			if _, err := backend.Write([]byte("output\n")); err != nil {
+				return
+			}
+		}
+	}()
+	session.recvCh <- closeFrame(nil)
+	waitForProxy(t, session, done)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import "sort"
+
+// DiffVars compares the vars stored on the deployment node with the vars observed on the backend
+// and returns the differences sorted by key (nil if there is no drift)
+func DiffVars(expected map[string]string, observed map[string]string) []*VarDiff {
+	var diffs []*VarDiff
+	for key, expectedValue := range expected {
+		expectedValue := expectedValue
+		observedValue, ok := observed[key]
+		if !ok {
+			diffs = append(diffs, &VarDiff{Key: key, Expected: &expectedValue})
+		} else if observedValue != expectedValue {
+			diffs = append(diffs, &VarDiff{Key: key, Expected: &expectedValue, Observed: &observedValue})
+		}
+	}
+	for key, observedValue := range observed {
+		observedValue := observedValue
+		if _, ok := expected[key]; !ok {
+			diffs = append(diffs, &VarDiff{Key: key, Observed: &observedValue})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+	return diffs
+}
+
+// NewRefreshResourceReply returns a successful RefreshResourceReply with the drift between the
+// stored and observed vars filled in
+func NewRefreshResourceReply(expected map[string]string, observed map[string]string) *RefreshResourceReply {
+	diffs := DiffVars(expected, observed)
+	return &RefreshResourceReply{
+		Success:      true,
+		ObservedVars: observed,
+		Drifted:      len(diffs) > 0,
+		Diffs:        diffs,
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import "testing"
+
+func TestDiffVars(t *testing.T) {
+	diffs := DiffVars(
+		map[string]string{"ip": "10.0.0.1", "name": "vm", "removed": "x"},
+		map[string]string{"ip": "10.0.0.2", "name": "vm", "added": "y"},
+	)
+	expected := []struct {
+		key      string
+		expected *string
+		observed *string
+	}{
+		{"added", nil, strPtr("y")},
+		{"ip", strPtr("10.0.0.1"), strPtr("10.0.0.2")},
+		{"removed", strPtr("x"), nil},
+	}
+	if len(diffs) != len(expected) {
+		t.Fatalf("expected %d diffs, got %v", len(expected), diffs)
+	}
+	for i, e := range expected {
+		diff := diffs[i]
+		if diff.Key != e.key || !equalPtr(diff.Expected, e.expected) || !equalPtr(diff.Observed, e.observed) {
+			t.Errorf("expected diff %d to be %s (%v -> %v), got %v", i, e.key, e.expected, e.observed, diff)
+		}
+	}
+}
+
+func TestDiffVarsNoDrift(t *testing.T) {
+	if diffs := DiffVars(map[string]string{"ip": "10.0.0.1"}, map[string]string{"ip": "10.0.0.1"}); diffs != nil {
+		t.Errorf("expected no diffs, got %v", diffs)
+	}
+	if diffs := DiffVars(nil, map[string]string{}); diffs != nil {
+		t.Errorf("expected no diffs between empty vars, got %v", diffs)
+	}
+
+	reply := NewRefreshResourceReply(map[string]string{"ip": "10.0.0.1"}, map[string]string{"ip": "10.0.0.1"})
+	if !reply.Success || reply.Drifted {
+		t.Errorf("expected a successful reply without drift, got %v", reply)
+	}
+	reply = NewRefreshResourceReply(map[string]string{"ip": "10.0.0.1"}, nil)
+	if !reply.Drifted || len(reply.Diffs) != 1 {
+		t.Errorf("expected a drifted reply with 1 diff, got %v", reply)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func equalPtr(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Environment variables used to pass server options to providers started by the launcher
+const (
+	EnvSocketID  = "CBLE_PROVIDER_SOCKET_ID"
+	EnvTLS       = "CBLE_PROVIDER_TLS"
+	EnvCertFile  = "CBLE_PROVIDER_CERT_FILE"
+	EnvKeyFile   = "CBLE_PROVIDER_KEY_FILE"
+	EnvCAFile    = "CBLE_PROVIDER_CA_FILE"
+	EnvAuthToken = "CBLE_PROVIDER_AUTH_TOKEN"
+)
+
+// ServerOptionsFromEnv returns the server options passed to the provider in its environment by the
+// launcher. The second return value is false if the provider was not started by the launcher
+func ServerOptionsFromEnv() (*ProviderServerOptions, bool, error) {
+	socketID, ok := os.LookupEnv(EnvSocketID)
+	if !ok {
+		return nil, false, nil
+	}
+	options := &ProviderServerOptions{
+		SocketID:  socketID,
+		CertFile:  os.Getenv(EnvCertFile),
+		KeyFile:   os.Getenv(EnvKeyFile),
+		CAFile:    os.Getenv(EnvCAFile),
+		AuthToken: os.Getenv(EnvAuthToken),
+	}
+	if tlsEnv := os.Getenv(EnvTLS); tlsEnv != "" {
+		tls, err := strconv.ParseBool(tlsEnv)
+		if err != nil {
+			return nil, true, fmt.Errorf("invalid value for %s: %v", EnvTLS, err)
+		}
+		options.TLS = tls
+	}
+	return options, true, nil
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	sync "sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// OperationIDMetadataKey is the gRPC metadata key carrying the ID of a deploy/destroy operation. The
+// provider server sends it in the response header at the start of every cancellable call, and
+// clients may set it in the request metadata to choose the ID up front (see WithOperationID)
+const OperationIDMetadataKey = "cble-operation-id"
+
+// cancellableMethods are the methods which are tracked as operations and may be cancelled
+var cancellableMethods = map[string]bool{
+	Provider_DeployResource_FullMethodName:       true,
+	Provider_DeployResourceStream_FullMethodName: true,
+	Provider_DestroyResource_FullMethodName:      true,
+	Provider_UpdateResource_FullMethodName:       true,
+}
+
+type operationIDKey struct{}
+
+type operationRegistryKey struct{}
+
+// operationRegistry tracks the in-flight operations of a single server, so operation IDs (including
+// client-chosen ones) are scoped to the server they were started on
+type operationRegistry struct {
+	mu         sync.Mutex
+	operations map[string]context.CancelFunc
+}
+
+// inFlightOperations counts the in-flight operations of all servers in this process
+var inFlightOperations int64
+
+func newOperationRegistry() *operationRegistry {
+	return &operationRegistry{
+		operations: make(map[string]context.CancelFunc),
+	}
+}
+
+// operationRegistryFromContext returns the operation registry of the server serving the call
+func operationRegistryFromContext(ctx context.Context) (*operationRegistry, bool) {
+	registry, ok := ctx.Value(operationRegistryKey{}).(*operationRegistry)
+	return registry, ok
+}
+
+// start registers a new cancellable operation and returns its context, ID and a function
+// to call once the operation has completed
+func (r *operationRegistry) start(ctx context.Context) (context.Context, string, func(), error) {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(OperationIDMetadataKey); len(ids) > 0 {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = NewOperationID()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.operations[id]; exists {
+		return nil, "", nil, status.Errorf(codes.AlreadyExists, "operation %s is already in progress", id)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	r.operations[id] = cancel
+	atomic.AddInt64(&inFlightOperations, 1)
+
+	done := func() {
+		r.mu.Lock()
+		delete(r.operations, id)
+		r.mu.Unlock()
+		atomic.AddInt64(&inFlightOperations, -1)
+		cancel()
+	}
+	return context.WithValue(ctx, operationIDKey{}, id), id, done, nil
+}
+
+// cancel cancels the context of the operation, returning false if no such operation is in-flight
+func (r *operationRegistry) cancel(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cancel, exists := r.operations[id]
+	if exists {
+		cancel()
+	}
+	return exists
+}
+
+// IsOperationMethod returns whether calls to the full method name are tracked as cancellable
+// operations, which carry the operation ID in their response header
+func IsOperationMethod(method string) bool {
+	return cancellableMethods[method]
+}
+
+// NewOperationID returns a new random operation ID
+func NewOperationID() string {
+	return uuid.New().String()
+}
+
+// WithOperationID returns a copy of the outgoing context which requests the given operation ID
+// for the deploy/destroy call, so the caller knows the ID to cancel before the call returns
+func WithOperationID(ctx context.Context, id string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, OperationIDMetadataKey, id)
+}
+
+// OperationIDFromHeader returns the operation ID from the response header of a deploy/destroy call
+func OperationIDFromHeader(header metadata.MD) string {
+	if ids := header.Get(OperationIDMetadataKey); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+// OperationIDFromContext returns the ID of the operation being served with this context
+func OperationIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(operationIDKey{}).(string)
+	return id, ok
+}
+
+// InFlightOperations returns the number of deploy/destroy operations currently being served by all
+// servers in this process
+func InFlightOperations() int {
+	return int(atomic.LoadInt64(&inFlightOperations))
+}
+
+// operationUnaryInterceptor tracks cancellable unary calls as operations in the registry, and makes
+// the registry available to CancelOperation
+func operationUnaryInterceptor(registry *operationRegistry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = context.WithValue(ctx, operationRegistryKey{}, registry)
+		if !cancellableMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, id, done, err := registry.start(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+		if err := grpc.SendHeader(ctx, metadata.Pairs(OperationIDMetadataKey, id)); err != nil {
+			logrus.WithField("component", "PROVIDER_GRPC_SERVER").Warnf("failed to send operation ID header: %v", err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// operationServerStream overrides the context of a grpc.ServerStream
+type operationServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *operationServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// operationStreamInterceptor tracks cancellable streaming calls as operations in the registry
+func operationStreamInterceptor(registry *operationRegistry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := context.WithValue(ss.Context(), operationRegistryKey{}, registry)
+		if !cancellableMethods[info.FullMethod] {
+			return handler(srv, &operationServerStream{ServerStream: ss, ctx: ctx})
+		}
+		ctx, id, done, err := registry.start(ctx)
+		if err != nil {
+			return err
+		}
+		defer done()
+		if err := ss.SendHeader(metadata.Pairs(OperationIDMetadataKey, id)); err != nil {
+			logrus.WithField("component", "PROVIDER_GRPC_SERVER").Warnf("failed to send operation ID header: %v", err)
+		}
+		return handler(srv, &operationServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -0,0 +1,94 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockingProvider blocks in DeployResource until the operation is cancelled
+type blockingProvider struct {
+	provider.DefaultProviderServer
+	started chan string
+}
+
+func (p blockingProvider) DeployResource(ctx context.Context, request *provider.DeployResourceRequest) (*provider.DeployResourceReply, error) {
+	id, _ := provider.OperationIDFromContext(ctx)
+	p.started <- id
+	<-ctx.Done()
+	return nil, status.FromContextError(ctx.Err()).Err()
+}
+
+func TestCancelOperationIsScopedToServer(t *testing.T) {
+	serverA := blockingProvider{started: make(chan string, 1)}
+	clientA := providertest.NewClient(t, serverA, nil)
+	clientB := providertest.NewClient(t, blockingProvider{started: make(chan string, 1)}, nil)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := clientA.DeployResource(provider.WithOperationID(context.Background(), "op1"), &provider.DeployResourceRequest{})
+		errCh <- err
+	}()
+	select {
+	case id := <-serverA.started:
+		if id != "op1" {
+			t.Fatalf("expected operation ID op1, got %s", id)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("deploy did not start")
+	}
+
+	// Another server must not know of the operation
+	reply, err := clientB.CancelOperation(context.Background(), &provider.CancelOperationRequest{OperationId: "op1"})
+	if err != nil {
+		t.Fatalf("CancelOperation failed: %v", err)
+	}
+	if reply.Success {
+		t.Fatalf("CancelOperation on another server cancelled the operation")
+	}
+	// The operation is still in-flight on its own server, so its ID cannot be reused there
+	_, err = clientA.DeployResource(provider.WithOperationID(context.Background(), "op1"), &provider.DeployResourceRequest{})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected the operation to still be in-flight on its server, got %v", err)
+	}
+
+	reply, err = clientA.CancelOperation(context.Background(), &provider.CancelOperationRequest{OperationId: "op1"})
+	if err != nil {
+		t.Fatalf("CancelOperation failed: %v", err)
+	}
+	if !reply.Success {
+		t.Fatalf("CancelOperation did not cancel the operation: %s", reply.GetError())
+	}
+	select {
+	case err := <-errCh:
+		if status.Code(err) != codes.Canceled {
+			t.Errorf("expected the deploy to be cancelled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("deploy was not cancelled")
+	}
+}
+
+func TestOperationIDsMayRepeatAcrossServers(t *testing.T) {
+	serverA := blockingProvider{started: make(chan string, 1)}
+	serverB := blockingProvider{started: make(chan string, 1)}
+	clientA := providertest.NewClient(t, serverA, nil)
+	clientB := providertest.NewClient(t, serverB, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go clientA.DeployResource(provider.WithOperationID(ctx, "op1"), &provider.DeployResourceRequest{})
+	go clientB.DeployResource(provider.WithOperationID(ctx, "op1"), &provider.DeployResourceRequest{})
+	for _, started := range []chan string{serverA.started, serverB.started} {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("deploy with a repeated operation ID did not start")
+		}
+	}
+}
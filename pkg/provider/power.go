@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	sync "sync"
+
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"google.golang.org/grpc"
+)
+
+// defaultBulkPowerConcurrency is the number of power operations BulkResourcePower runs at once by default
+const defaultBulkPowerConcurrency = 10
+
+// BulkResourcePower runs the unary power function concurrently (at most maxConcurrency at once,
+// defaults to 10 if <= 0) for every request and collects the results keyed by resource ID. Every
+// request must have a unique, non-empty resource ID. A panic in the power function fails only that
+// resource's operation. Providers can implement BulkResourcePower on top of their existing
+// ResourcePower like so:
+//
+//	func (p MyProvider) BulkResourcePower(ctx context.Context, request *provider.BulkResourcePowerRequest) (*provider.BulkResourcePowerReply, error) {
+//		return provider.BulkResourcePower(ctx, request, 0, p.ResourcePower)
+//	}
+func BulkResourcePower(ctx context.Context, request *BulkResourcePowerRequest, maxConcurrency int, power func(context.Context, *ResourcePowerRequest) (*ResourcePowerReply, error)) (*BulkResourcePowerReply, error) {
+	// Results are keyed by resource ID, so empty or duplicate IDs would silently drop results
+	ids := make(map[string]bool, len(request.Requests))
+	for _, powerRequest := range request.Requests {
+		id := powerRequest.GetResource().GetId()
+		if id == "" {
+			return nil, common.NewProviderError(common.ErrorCategory_INVALID_INPUT, "resource %s has no ID", powerRequest.GetResource().GetKey()).
+				WithResourceKey(powerRequest.GetResource().GetKey()).Err()
+		}
+		if ids[id] {
+			return nil, common.NewProviderError(common.ErrorCategory_INVALID_INPUT, "duplicate power request for resource ID %s", id).
+				WithResourceKey(powerRequest.GetResource().GetKey()).Err()
+		}
+		ids[id] = true
+	}
+
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultBulkPowerConcurrency
+	}
+	reply := &BulkResourcePowerReply{
+		Success: true,
+		Results: make(map[string]*ResourcePowerReply, len(request.Requests)),
+	}
+
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	sem := make(chan struct{}, maxConcurrency)
+	for _, powerRequest := range request.Requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(powerRequest *ResourcePowerRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			powerReply, err := resourcePower(ctx, powerRequest, power)
+			if err != nil {
+				errStr := err.Error()
+				powerReply = &ResourcePowerReply{
+					Success: false,
+					Error:   &errStr,
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			reply.Results[powerRequest.GetResource().GetId()] = powerReply
+			if !powerReply.Success {
+				reply.Success = false
+			}
+		}(powerRequest)
+	}
+	wg.Wait()
+
+	if !reply.Success {
+		errStr := "one or more power operations failed"
+		reply.Error = &errStr
+	}
+	return reply, nil
+}
+
+// resourcePower validates the power request before passing it to the power function
+func resourcePower(ctx context.Context, request *ResourcePowerRequest, power func(context.Context, *ResourcePowerRequest) (*ResourcePowerReply, error)) (reply *ResourcePowerReply, err error) {
+	// The recovery interceptor only covers the handler goroutine, so recover here too
+	defer func() {
+		if r := recover(); r != nil {
+			reply, err = nil, recoverPanic(Provider_ResourcePower_FullMethodName, request, r)
+		}
+	}()
+	if err := ValidateResourcePowerRequest(request); err != nil {
+		return nil, err
+	}
+	reply, err = power(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, fmt.Errorf("power returned a nil reply for resource %s", request.GetResource().GetKey())
+	}
+	return reply, nil
+}
+
+// ValidateResourcePowerRequest returns an INVALID_INPUT error if the power request has no power
+// state. The provider server validates ResourcePower calls with it before they reach the provider,
+// and BulkResourcePower validates each of its requests
+func ValidateResourcePowerRequest(request *ResourcePowerRequest) error {
+	if request.GetState() == PowerState_UNSPECIFIED {
+		return common.NewProviderError(common.ErrorCategory_INVALID_INPUT, "power state must be specified for resource %s", request.GetResource().GetKey()).
+			WithResourceKey(request.GetResource().GetKey()).Err()
+	}
+	return nil
+}
+
+// powerValidationUnaryInterceptor rejects invalid ResourcePower calls before they reach the provider
+func powerValidationUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if request, ok := req.(*ResourcePowerRequest); ok && info.FullMethod == Provider_ResourcePower_FullMethodName {
+		if err := ValidateResourcePowerRequest(request); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, req)
+}
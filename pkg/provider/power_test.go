@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"google.golang.org/grpc"
+)
+
+func bulkPowerRequest(ids ...string) *BulkResourcePowerRequest {
+	request := &BulkResourcePowerRequest{}
+	for _, id := range ids {
+		request.Requests = append(request.Requests, &ResourcePowerRequest{
+			Resource: &Resource{Id: id, Key: "key-" + id},
+			State:    PowerState_ON,
+		})
+	}
+	return request
+}
+
+func TestBulkResourcePowerRecoversPanics(t *testing.T) {
+	reply, err := BulkResourcePower(context.Background(), bulkPowerRequest("a", "b"), 0, func(ctx context.Context, request *ResourcePowerRequest) (*ResourcePowerReply, error) {
+		if request.Resource.Id == "a" {
+			panic("boom")
+		}
+		return &ResourcePowerReply{Success: true}, nil
+	})
+	if err != nil {
+		t.Fatalf("BulkResourcePower failed: %v", err)
+	}
+	if reply.Success {
+		t.Errorf("expected the bulk reply to fail")
+	}
+	if reply.Results["a"].GetSuccess() || reply.Results["a"].Error == nil {
+		t.Errorf("expected the panicking operation to fail with an error, got %v", reply.Results["a"])
+	}
+	if !reply.Results["b"].GetSuccess() {
+		t.Errorf("expected the other operation to succeed, got %v", reply.Results["b"])
+	}
+}
+
+func TestBulkResourcePowerConcurrencyLimit(t *testing.T) {
+	var ids []string
+	for i := 0; i < 20; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+	var running, maxRunning int32
+	reply, err := BulkResourcePower(context.Background(), bulkPowerRequest(ids...), 3, func(ctx context.Context, request *ResourcePowerRequest) (*ResourcePowerReply, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return &ResourcePowerReply{Success: true}, nil
+	})
+	if err != nil {
+		t.Fatalf("BulkResourcePower failed: %v", err)
+	}
+	if !reply.Success || len(reply.Results) != len(ids) {
+		t.Errorf("expected %d successful results, got %v", len(ids), reply)
+	}
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 concurrent operations, got %d", maxRunning)
+	}
+}
+
+func TestBulkResourcePowerRejectsInvalidIDs(t *testing.T) {
+	power := func(ctx context.Context, request *ResourcePowerRequest) (*ResourcePowerReply, error) {
+		t.Errorf("power should not be called")
+		return &ResourcePowerReply{Success: true}, nil
+	}
+	for name, request := range map[string]*BulkResourcePowerRequest{
+		"empty":     bulkPowerRequest("a", ""),
+		"duplicate": bulkPowerRequest("a", "b", "a"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := BulkResourcePower(context.Background(), request, 0, power)
+			if !common.IsCategory(err, common.ErrorCategory_INVALID_INPUT) {
+				t.Errorf("expected an INVALID_INPUT error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestResourcePowerRejectsUnspecifiedState(t *testing.T) {
+	request := &ResourcePowerRequest{Resource: &Resource{Id: "a", Key: "vm"}}
+	if err := ValidateResourcePowerRequest(request); !common.IsCategory(err, common.ErrorCategory_INVALID_INPUT) {
+		t.Errorf("expected an INVALID_INPUT error, got %v", err)
+	}
+	if err := ValidateResourcePowerRequest(&ResourcePowerRequest{State: PowerState_OFF}); err != nil {
+		t.Errorf("expected a power state to be valid, got %v", err)
+	}
+
+	// Single ResourcePower calls are rejected by the server before reaching the provider
+	_, err := powerValidationUnaryInterceptor(context.Background(), request, &grpc.UnaryServerInfo{FullMethod: Provider_ResourcePower_FullMethodName}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Errorf("the provider should not be called")
+		return &ResourcePowerReply{Success: true}, nil
+	})
+	if !common.IsCategory(err, common.ErrorCategory_INVALID_INPUT) {
+		t.Errorf("expected an INVALID_INPUT error, got %v", err)
+	}
+
+	// Bulk calls fail the request the same way
+	reply, err := BulkResourcePower(context.Background(), &BulkResourcePowerRequest{Requests: []*ResourcePowerRequest{request}}, 0, func(ctx context.Context, request *ResourcePowerRequest) (*ResourcePowerReply, error) {
+		t.Errorf("the provider should not be called")
+		return &ResourcePowerReply{Success: true}, nil
+	})
+	if err != nil {
+		t.Fatalf("BulkResourcePower failed: %v", err)
+	}
+	if result := reply.Results["a"]; result.GetSuccess() || !strings.Contains(result.GetError(), "power state must be specified") {
+		t.Errorf("expected the request to fail for its unspecified state, got %v", result)
+	}
+}
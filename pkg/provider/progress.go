@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	sync "sync"
+)
+
+type deployProgressKey struct{}
+
+// DeployProgressReporter sends progress messages on a DeployResourceStream. All methods are safe
+// to call on a nil reporter (they do nothing), so deploy logic can report progress whether it was
+// called from DeployResource or DeployResourceStream
+type DeployProgressReporter struct {
+	mu      sync.Mutex
+	stream  Provider_DeployResourceStreamServer
+	phase   string
+	percent float32
+}
+
+// NewDeployProgressReporter returns a DeployProgressReporter which sends progress on the given stream
+func NewDeployProgressReporter(stream Provider_DeployResourceStreamServer) *DeployProgressReporter {
+	return &DeployProgressReporter{stream: stream}
+}
+
+// DeployProgressReporterFromContext returns the DeployProgressReporter stored in the context (or nil if none)
+func DeployProgressReporterFromContext(ctx context.Context) *DeployProgressReporter {
+	reporter, _ := ctx.Value(deployProgressKey{}).(*DeployProgressReporter)
+	return reporter
+}
+
+// ContextWithDeployProgressReporter returns a copy of the context which carries the reporter
+func ContextWithDeployProgressReporter(ctx context.Context, reporter *DeployProgressReporter) context.Context {
+	return context.WithValue(ctx, deployProgressKey{}, reporter)
+}
+
+// SetPhase reports the current phase and percent complete of the deploy
+func (r *DeployProgressReporter) SetPhase(phase string, percent float32) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.phase = phase
+	r.percent = percent
+	return r.send(&DeployResourceProgress{})
+}
+
+// Logf reports a human-readable log line for the deploy
+func (r *DeployProgressReporter) Logf(format string, args ...interface{}) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.send(&DeployResourceProgress{
+		LogLines: []string{fmt.Sprintf(format, args...)},
+	})
+}
+
+// UpdateVars reports a partial update to the vars of the deployment node
+func (r *DeployProgressReporter) UpdateVars(vars map[string]string) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.send(&DeployResourceProgress{
+		UpdatedVars: vars,
+	})
+}
+
+// finish sends the final progress message containing the result of the deploy
+func (r *DeployProgressReporter) finish(reply *DeployResourceReply) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if reply == nil {
+		return fmt.Errorf("deploy returned a nil reply")
+	}
+	if reply.Success {
+		r.percent = 100
+	}
+	return r.send(&DeployResourceProgress{
+		Result: reply,
+	})
+}
+
+// send fills in the current phase and percent and sends the message (caller must hold the lock)
+func (r *DeployProgressReporter) send(progress *DeployResourceProgress) error {
+	progress.Phase = r.phase
+	progress.Percent = r.percent
+	return r.stream.Send(progress)
+}
+
+// StreamDeployResource runs the unary deploy function with a DeployProgressReporter in its
+// context and sends the result as the final progress message. Providers can implement
+// DeployResourceStream on top of their existing DeployResource like so:
+//
+//	func (p MyProvider) DeployResourceStream(request *provider.DeployResourceRequest, stream provider.Provider_DeployResourceStreamServer) error {
+//		return provider.StreamDeployResource(request, stream, p.DeployResource)
+//	}
+func StreamDeployResource(request *DeployResourceRequest, stream Provider_DeployResourceStreamServer, deploy func(context.Context, *DeployResourceRequest) (*DeployResourceReply, error)) error {
+	reporter := NewDeployProgressReporter(stream)
+	reply, err := deploy(ContextWithDeployProgressReporter(stream.Context(), reporter), request)
+	if err != nil {
+		return err
+	}
+	return reporter.finish(reply)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// recordingDeployStream records the progress messages sent on a DeployResourceStream
+type recordingDeployStream struct {
+	grpc.ServerStream
+	sent []*DeployResourceProgress
+}
+
+func (s *recordingDeployStream) Send(progress *DeployResourceProgress) error {
+	s.sent = append(s.sent, progress)
+	return nil
+}
+
+func (s *recordingDeployStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestDeployProgressReporterMessages(t *testing.T) {
+	stream := &recordingDeployStream{}
+	err := StreamDeployResource(&DeployResourceRequest{}, stream, func(ctx context.Context, request *DeployResourceRequest) (*DeployResourceReply, error) {
+		reporter := DeployProgressReporterFromContext(ctx)
+		if reporter == nil {
+			t.Fatal("expected a reporter in the deploy context")
+		}
+		reporter.SetPhase("provisioning", 10)
+		reporter.Logf("created %s", "vm")
+		reporter.UpdateVars(map[string]string{"ip": "10.0.0.1"})
+		reporter.SetPhase("configuring", 60)
+		return &DeployResourceReply{Success: true, UpdatedVars: map[string]string{"id": "vm-1"}}, nil
+	})
+	if err != nil {
+		t.Fatalf("StreamDeployResource failed: %v", err)
+	}
+
+	expected := []struct {
+		phase   string
+		percent float32
+	}{
+		{"provisioning", 10},
+		{"provisioning", 10},
+		{"provisioning", 10},
+		{"configuring", 60},
+		{"configuring", 100},
+	}
+	if len(stream.sent) != len(expected) {
+		t.Fatalf("expected %d messages, got %d: %v", len(expected), len(stream.sent), stream.sent)
+	}
+	for i, e := range expected {
+		if stream.sent[i].Phase != e.phase || stream.sent[i].Percent != e.percent {
+			t.Errorf("expected message %d to be %s at %v%%, got %s at %v%%", i, e.phase, e.percent, stream.sent[i].Phase, stream.sent[i].Percent)
+		}
+		if i < len(expected)-1 && stream.sent[i].Result != nil {
+			t.Errorf("expected only the last message to carry the result, got one on message %d", i)
+		}
+	}
+	if lines := stream.sent[1].LogLines; len(lines) != 1 || lines[0] != "created vm" {
+		t.Errorf("expected the log line %q, got %v", "created vm", lines)
+	}
+	if vars := stream.sent[2].UpdatedVars; vars["ip"] != "10.0.0.1" {
+		t.Errorf("expected the updated vars, got %v", vars)
+	}
+	if result := stream.sent[4].Result; !result.GetSuccess() || result.UpdatedVars["id"] != "vm-1" {
+		t.Errorf("expected the final message to carry the successful result, got %v", result)
+	}
+}
+
+func TestDeployProgressReporterFailure(t *testing.T) {
+	stream := &recordingDeployStream{}
+	errStr := "quota exceeded"
+	err := StreamDeployResource(&DeployResourceRequest{}, stream, func(ctx context.Context, request *DeployResourceRequest) (*DeployResourceReply, error) {
+		DeployProgressReporterFromContext(ctx).SetPhase("provisioning", 40)
+		return &DeployResourceReply{Success: false, Error: &errStr}, nil
+	})
+	if err != nil {
+		t.Fatalf("StreamDeployResource failed: %v", err)
+	}
+	if len(stream.sent) != 2 {
+		t.Fatalf("expected 2 messages, got %v", stream.sent)
+	}
+	final := stream.sent[1]
+	if final.Result.GetSuccess() || final.Result.GetError() != errStr {
+		t.Errorf("expected the final message to carry the failed result, got %v", final.Result)
+	}
+	// A failed deploy does not complete
+	if final.Phase != "provisioning" || final.Percent != 40 {
+		t.Errorf("expected the final message to keep the last phase and percent, got %s at %v%%", final.Phase, final.Percent)
+	}
+}
+
+func TestStreamDeployResourceErrors(t *testing.T) {
+	stream := &recordingDeployStream{}
+	deployErr := errors.New("backend unavailable")
+	err := StreamDeployResource(&DeployResourceRequest{}, stream, func(ctx context.Context, request *DeployResourceRequest) (*DeployResourceReply, error) {
+		return nil, deployErr
+	})
+	if err != deployErr {
+		t.Errorf("expected the deploy error, got %v", err)
+	}
+	if len(stream.sent) != 0 {
+		t.Errorf("expected no result to be sent for a failed deploy, got %v", stream.sent)
+	}
+
+	err = StreamDeployResource(&DeployResourceRequest{}, stream, func(ctx context.Context, request *DeployResourceRequest) (*DeployResourceReply, error) {
+		return nil, nil
+	})
+	if err == nil {
+		t.Errorf("expected an error for a nil reply")
+	}
+}
+
+func TestNilDeployProgressReporter(t *testing.T) {
+	// Deploy logic called from DeployResource has no reporter
+	reporter := DeployProgressReporterFromContext(context.Background())
+	if reporter != nil {
+		t.Fatalf("expected no reporter, got %v", reporter)
+	}
+	if err := reporter.SetPhase("provisioning", 10); err != nil {
+		t.Errorf("SetPhase on a nil reporter failed: %v", err)
+	}
+	if err := reporter.Logf("line"); err != nil {
+		t.Errorf("Logf on a nil reporter failed: %v", err)
+	}
+	if err := reporter.UpdateVars(map[string]string{"ip": "10.0.0.1"}); err != nil {
+		t.Errorf("UpdateVars on a nil reporter failed: %v", err)
+	}
+}
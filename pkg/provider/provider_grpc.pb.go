@@ -27,9 +27,23 @@ const (
 	Provider_ExtractResourceMetadata_FullMethodName = "/Provider/ExtractResourceMetadata"
 	Provider_RetrieveData_FullMethodName            = "/Provider/RetrieveData"
 	Provider_DeployResource_FullMethodName          = "/Provider/DeployResource"
+	Provider_DeployResourceStream_FullMethodName    = "/Provider/DeployResourceStream"
 	Provider_DestroyResource_FullMethodName         = "/Provider/DestroyResource"
+	Provider_UpdateResource_FullMethodName          = "/Provider/UpdateResource"
+	Provider_RefreshResource_FullMethodName         = "/Provider/RefreshResource"
+	Provider_ImportResource_FullMethodName          = "/Provider/ImportResource"
+	Provider_PlanDeployResource_FullMethodName      = "/Provider/PlanDeployResource"
+	Provider_PlanDestroyResource_FullMethodName     = "/Provider/PlanDestroyResource"
 	Provider_GetConsole_FullMethodName              = "/Provider/GetConsole"
+	Provider_ConsoleSession_FullMethodName          = "/Provider/ConsoleSession"
 	Provider_ResourcePower_FullMethodName           = "/Provider/ResourcePower"
+	Provider_GetResourcePower_FullMethodName        = "/Provider/GetResourcePower"
+	Provider_BulkResourcePower_FullMethodName       = "/Provider/BulkResourcePower"
+	Provider_CreateSnapshot_FullMethodName          = "/Provider/CreateSnapshot"
+	Provider_ListSnapshots_FullMethodName           = "/Provider/ListSnapshots"
+	Provider_RevertSnapshot_FullMethodName          = "/Provider/RevertSnapshot"
+	Provider_DeleteSnapshot_FullMethodName          = "/Provider/DeleteSnapshot"
+	Provider_CancelOperation_FullMethodName         = "/Provider/CancelOperation"
 )
 
 // ProviderClient is the client API for Provider service.
@@ -41,9 +55,23 @@ type ProviderClient interface {
 	ExtractResourceMetadata(ctx context.Context, in *ExtractResourceMetadataRequest, opts ...grpc.CallOption) (*ExtractResourceMetadataReply, error)
 	RetrieveData(ctx context.Context, in *RetrieveDataRequest, opts ...grpc.CallOption) (*RetrieveDataReply, error)
 	DeployResource(ctx context.Context, in *DeployResourceRequest, opts ...grpc.CallOption) (*DeployResourceReply, error)
+	DeployResourceStream(ctx context.Context, in *DeployResourceRequest, opts ...grpc.CallOption) (Provider_DeployResourceStreamClient, error)
 	DestroyResource(ctx context.Context, in *DestroyResourceRequest, opts ...grpc.CallOption) (*DestroyResourceReply, error)
+	UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*UpdateResourceReply, error)
+	RefreshResource(ctx context.Context, in *RefreshResourceRequest, opts ...grpc.CallOption) (*RefreshResourceReply, error)
+	ImportResource(ctx context.Context, in *ImportResourceRequest, opts ...grpc.CallOption) (*ImportResourceReply, error)
+	PlanDeployResource(ctx context.Context, in *DeployResourceRequest, opts ...grpc.CallOption) (*PlanResourceReply, error)
+	PlanDestroyResource(ctx context.Context, in *DestroyResourceRequest, opts ...grpc.CallOption) (*PlanResourceReply, error)
 	GetConsole(ctx context.Context, in *GetConsoleRequest, opts ...grpc.CallOption) (*GetConsoleReply, error)
+	ConsoleSession(ctx context.Context, opts ...grpc.CallOption) (Provider_ConsoleSessionClient, error)
 	ResourcePower(ctx context.Context, in *ResourcePowerRequest, opts ...grpc.CallOption) (*ResourcePowerReply, error)
+	GetResourcePower(ctx context.Context, in *GetResourcePowerRequest, opts ...grpc.CallOption) (*GetResourcePowerReply, error)
+	BulkResourcePower(ctx context.Context, in *BulkResourcePowerRequest, opts ...grpc.CallOption) (*BulkResourcePowerReply, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotReply, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error)
+	RevertSnapshot(ctx context.Context, in *RevertSnapshotRequest, opts ...grpc.CallOption) (*RevertSnapshotReply, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotReply, error)
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationReply, error)
 }
 
 type providerClient struct {
@@ -99,6 +127,38 @@ func (c *providerClient) DeployResource(ctx context.Context, in *DeployResourceR
 	return out, nil
 }
 
+func (c *providerClient) DeployResourceStream(ctx context.Context, in *DeployResourceRequest, opts ...grpc.CallOption) (Provider_DeployResourceStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[0], Provider_DeployResourceStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerDeployResourceStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Provider_DeployResourceStreamClient interface {
+	Recv() (*DeployResourceProgress, error)
+	grpc.ClientStream
+}
+
+type providerDeployResourceStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerDeployResourceStreamClient) Recv() (*DeployResourceProgress, error) {
+	m := new(DeployResourceProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *providerClient) DestroyResource(ctx context.Context, in *DestroyResourceRequest, opts ...grpc.CallOption) (*DestroyResourceReply, error) {
 	out := new(DestroyResourceReply)
 	err := c.cc.Invoke(ctx, Provider_DestroyResource_FullMethodName, in, out, opts...)
@@ -108,6 +168,51 @@ func (c *providerClient) DestroyResource(ctx context.Context, in *DestroyResourc
 	return out, nil
 }
 
+func (c *providerClient) UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*UpdateResourceReply, error) {
+	out := new(UpdateResourceReply)
+	err := c.cc.Invoke(ctx, Provider_UpdateResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RefreshResource(ctx context.Context, in *RefreshResourceRequest, opts ...grpc.CallOption) (*RefreshResourceReply, error) {
+	out := new(RefreshResourceReply)
+	err := c.cc.Invoke(ctx, Provider_RefreshResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ImportResource(ctx context.Context, in *ImportResourceRequest, opts ...grpc.CallOption) (*ImportResourceReply, error) {
+	out := new(ImportResourceReply)
+	err := c.cc.Invoke(ctx, Provider_ImportResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) PlanDeployResource(ctx context.Context, in *DeployResourceRequest, opts ...grpc.CallOption) (*PlanResourceReply, error) {
+	out := new(PlanResourceReply)
+	err := c.cc.Invoke(ctx, Provider_PlanDeployResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) PlanDestroyResource(ctx context.Context, in *DestroyResourceRequest, opts ...grpc.CallOption) (*PlanResourceReply, error) {
+	out := new(PlanResourceReply)
+	err := c.cc.Invoke(ctx, Provider_PlanDestroyResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) GetConsole(ctx context.Context, in *GetConsoleRequest, opts ...grpc.CallOption) (*GetConsoleReply, error) {
 	out := new(GetConsoleReply)
 	err := c.cc.Invoke(ctx, Provider_GetConsole_FullMethodName, in, out, opts...)
@@ -117,6 +222,37 @@ func (c *providerClient) GetConsole(ctx context.Context, in *GetConsoleRequest,
 	return out, nil
 }
 
+func (c *providerClient) ConsoleSession(ctx context.Context, opts ...grpc.CallOption) (Provider_ConsoleSessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[1], Provider_ConsoleSession_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerConsoleSessionClient{stream}
+	return x, nil
+}
+
+type Provider_ConsoleSessionClient interface {
+	Send(*ConsoleFrame) error
+	Recv() (*ConsoleFrame, error)
+	grpc.ClientStream
+}
+
+type providerConsoleSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerConsoleSessionClient) Send(m *ConsoleFrame) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *providerConsoleSessionClient) Recv() (*ConsoleFrame, error) {
+	m := new(ConsoleFrame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *providerClient) ResourcePower(ctx context.Context, in *ResourcePowerRequest, opts ...grpc.CallOption) (*ResourcePowerReply, error) {
 	out := new(ResourcePowerReply)
 	err := c.cc.Invoke(ctx, Provider_ResourcePower_FullMethodName, in, out, opts...)
@@ -126,6 +262,69 @@ func (c *providerClient) ResourcePower(ctx context.Context, in *ResourcePowerReq
 	return out, nil
 }
 
+func (c *providerClient) GetResourcePower(ctx context.Context, in *GetResourcePowerRequest, opts ...grpc.CallOption) (*GetResourcePowerReply, error) {
+	out := new(GetResourcePowerReply)
+	err := c.cc.Invoke(ctx, Provider_GetResourcePower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) BulkResourcePower(ctx context.Context, in *BulkResourcePowerRequest, opts ...grpc.CallOption) (*BulkResourcePowerReply, error) {
+	out := new(BulkResourcePowerReply)
+	err := c.cc.Invoke(ctx, Provider_BulkResourcePower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotReply, error) {
+	out := new(CreateSnapshotReply)
+	err := c.cc.Invoke(ctx, Provider_CreateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error) {
+	out := new(ListSnapshotsReply)
+	err := c.cc.Invoke(ctx, Provider_ListSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RevertSnapshot(ctx context.Context, in *RevertSnapshotRequest, opts ...grpc.CallOption) (*RevertSnapshotReply, error) {
+	out := new(RevertSnapshotReply)
+	err := c.cc.Invoke(ctx, Provider_RevertSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DeleteSnapshotReply, error) {
+	out := new(DeleteSnapshotReply)
+	err := c.cc.Invoke(ctx, Provider_DeleteSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*CancelOperationReply, error) {
+	out := new(CancelOperationReply)
+	err := c.cc.Invoke(ctx, Provider_CancelOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProviderServer is the server API for Provider service.
 // All implementations must embed UnimplementedProviderServer
 // for forward compatibility
@@ -135,9 +334,23 @@ type ProviderServer interface {
 	ExtractResourceMetadata(context.Context, *ExtractResourceMetadataRequest) (*ExtractResourceMetadataReply, error)
 	RetrieveData(context.Context, *RetrieveDataRequest) (*RetrieveDataReply, error)
 	DeployResource(context.Context, *DeployResourceRequest) (*DeployResourceReply, error)
+	DeployResourceStream(*DeployResourceRequest, Provider_DeployResourceStreamServer) error
 	DestroyResource(context.Context, *DestroyResourceRequest) (*DestroyResourceReply, error)
+	UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceReply, error)
+	RefreshResource(context.Context, *RefreshResourceRequest) (*RefreshResourceReply, error)
+	ImportResource(context.Context, *ImportResourceRequest) (*ImportResourceReply, error)
+	PlanDeployResource(context.Context, *DeployResourceRequest) (*PlanResourceReply, error)
+	PlanDestroyResource(context.Context, *DestroyResourceRequest) (*PlanResourceReply, error)
 	GetConsole(context.Context, *GetConsoleRequest) (*GetConsoleReply, error)
+	ConsoleSession(Provider_ConsoleSessionServer) error
 	ResourcePower(context.Context, *ResourcePowerRequest) (*ResourcePowerReply, error)
+	GetResourcePower(context.Context, *GetResourcePowerRequest) (*GetResourcePowerReply, error)
+	BulkResourcePower(context.Context, *BulkResourcePowerRequest) (*BulkResourcePowerReply, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotReply, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsReply, error)
+	RevertSnapshot(context.Context, *RevertSnapshotRequest) (*RevertSnapshotReply, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotReply, error)
+	CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationReply, error)
 	mustEmbedUnimplementedProviderServer()
 }
 
@@ -160,15 +373,57 @@ func (UnimplementedProviderServer) RetrieveData(context.Context, *RetrieveDataRe
 func (UnimplementedProviderServer) DeployResource(context.Context, *DeployResourceRequest) (*DeployResourceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployResource not implemented")
 }
+func (UnimplementedProviderServer) DeployResourceStream(*DeployResourceRequest, Provider_DeployResourceStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeployResourceStream not implemented")
+}
 func (UnimplementedProviderServer) DestroyResource(context.Context, *DestroyResourceRequest) (*DestroyResourceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyResource not implemented")
 }
+func (UnimplementedProviderServer) UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResource not implemented")
+}
+func (UnimplementedProviderServer) RefreshResource(context.Context, *RefreshResourceRequest) (*RefreshResourceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshResource not implemented")
+}
+func (UnimplementedProviderServer) ImportResource(context.Context, *ImportResourceRequest) (*ImportResourceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportResource not implemented")
+}
+func (UnimplementedProviderServer) PlanDeployResource(context.Context, *DeployResourceRequest) (*PlanResourceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanDeployResource not implemented")
+}
+func (UnimplementedProviderServer) PlanDestroyResource(context.Context, *DestroyResourceRequest) (*PlanResourceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanDestroyResource not implemented")
+}
 func (UnimplementedProviderServer) GetConsole(context.Context, *GetConsoleRequest) (*GetConsoleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConsole not implemented")
 }
+func (UnimplementedProviderServer) ConsoleSession(Provider_ConsoleSessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConsoleSession not implemented")
+}
 func (UnimplementedProviderServer) ResourcePower(context.Context, *ResourcePowerRequest) (*ResourcePowerReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourcePower not implemented")
 }
+func (UnimplementedProviderServer) GetResourcePower(context.Context, *GetResourcePowerRequest) (*GetResourcePowerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourcePower not implemented")
+}
+func (UnimplementedProviderServer) BulkResourcePower(context.Context, *BulkResourcePowerRequest) (*BulkResourcePowerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkResourcePower not implemented")
+}
+func (UnimplementedProviderServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedProviderServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedProviderServer) RevertSnapshot(context.Context, *RevertSnapshotRequest) (*RevertSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertSnapshot not implemented")
+}
+func (UnimplementedProviderServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DeleteSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedProviderServer) CancelOperation(context.Context, *CancelOperationRequest) (*CancelOperationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
 func (UnimplementedProviderServer) mustEmbedUnimplementedProviderServer() {}
 
 // UnsafeProviderServer may be embedded to opt out of forward compatibility for this service.
@@ -272,6 +527,27 @@ func _Provider_DeployResource_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_DeployResourceStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeployResourceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).DeployResourceStream(m, &providerDeployResourceStreamServer{stream})
+}
+
+type Provider_DeployResourceStreamServer interface {
+	Send(*DeployResourceProgress) error
+	grpc.ServerStream
+}
+
+type providerDeployResourceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerDeployResourceStreamServer) Send(m *DeployResourceProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Provider_DestroyResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyResourceRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +566,96 @@ func _Provider_DestroyResource_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_UpdateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).UpdateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_UpdateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).UpdateResource(ctx, req.(*UpdateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RefreshResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RefreshResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_RefreshResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RefreshResource(ctx, req.(*RefreshResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ImportResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ImportResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ImportResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ImportResource(ctx, req.(*ImportResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_PlanDeployResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).PlanDeployResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_PlanDeployResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).PlanDeployResource(ctx, req.(*DeployResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_PlanDestroyResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DestroyResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).PlanDestroyResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_PlanDestroyResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).PlanDestroyResource(ctx, req.(*DestroyResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_GetConsole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConsoleRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +674,32 @@ func _Provider_GetConsole_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_ConsoleSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProviderServer).ConsoleSession(&providerConsoleSessionServer{stream})
+}
+
+type Provider_ConsoleSessionServer interface {
+	Send(*ConsoleFrame) error
+	Recv() (*ConsoleFrame, error)
+	grpc.ServerStream
+}
+
+type providerConsoleSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerConsoleSessionServer) Send(m *ConsoleFrame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *providerConsoleSessionServer) Recv() (*ConsoleFrame, error) {
+	m := new(ConsoleFrame)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Provider_ResourcePower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcePowerRequest)
 	if err := dec(in); err != nil {
@@ -326,6 +718,132 @@ func _Provider_ResourcePower_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_GetResourcePower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourcePowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetResourcePower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_GetResourcePower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetResourcePower(ctx, req.(*GetResourcePowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_BulkResourcePower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkResourcePowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).BulkResourcePower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_BulkResourcePower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).BulkResourcePower(ctx, req.(*BulkResourcePowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RevertSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RevertSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_RevertSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RevertSnapshot(ctx, req.(*RevertSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_DeleteSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provider_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Provider_ServiceDesc is the grpc.ServiceDesc for Provider service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -357,6 +875,26 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyResource",
 			Handler:    _Provider_DestroyResource_Handler,
 		},
+		{
+			MethodName: "UpdateResource",
+			Handler:    _Provider_UpdateResource_Handler,
+		},
+		{
+			MethodName: "RefreshResource",
+			Handler:    _Provider_RefreshResource_Handler,
+		},
+		{
+			MethodName: "ImportResource",
+			Handler:    _Provider_ImportResource_Handler,
+		},
+		{
+			MethodName: "PlanDeployResource",
+			Handler:    _Provider_PlanDeployResource_Handler,
+		},
+		{
+			MethodName: "PlanDestroyResource",
+			Handler:    _Provider_PlanDestroyResource_Handler,
+		},
 		{
 			MethodName: "GetConsole",
 			Handler:    _Provider_GetConsole_Handler,
@@ -365,7 +903,47 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResourcePower",
 			Handler:    _Provider_ResourcePower_Handler,
 		},
+		{
+			MethodName: "GetResourcePower",
+			Handler:    _Provider_GetResourcePower_Handler,
+		},
+		{
+			MethodName: "BulkResourcePower",
+			Handler:    _Provider_BulkResourcePower_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Provider_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _Provider_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "RevertSnapshot",
+			Handler:    _Provider_RevertSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _Provider_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _Provider_CancelOperation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DeployResourceStream",
+			Handler:       _Provider_DeployResourceStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ConsoleSession",
+			Handler:       _Provider_ConsoleSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "provider.proto",
 }
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryInterceptor recovers from panics in unary handlers, logging the stack trace and
+// returning codes.Internal instead of crashing the provider process. Serve installs it by default
+func RecoveryUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (reply interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverPanic(info.FullMethod, req, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// RecoveryStreamInterceptor recovers from panics in streaming handlers, logging the stack trace
+// and returning codes.Internal instead of crashing the provider process. Serve installs it by default
+func RecoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	stream := &recoveryServerStream{ServerStream: ss}
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoverPanic(info.FullMethod, stream.lastReq, r)
+		}
+	}()
+	return handler(srv, stream)
+}
+
+// recoveryServerStream records the last message received so it can be logged on panic
+type recoveryServerStream struct {
+	grpc.ServerStream
+	lastReq interface{}
+}
+
+func (s *recoveryServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.lastReq = m
+	return nil
+}
+
+// recoverPanic logs the panic with the resource keys and deployment ID of the request (if any)
+// and returns the error to send to the client. The panic details are only logged, as they may
+// contain sensitive data
+func recoverPanic(method string, req interface{}, r interface{}) error {
+	fields := logrus.Fields{
+		"component": "PROVIDER_GRPC_SERVER",
+		"method":    method,
+	}
+	if resources := RequestResources(req); len(resources) == 1 {
+		fields["resource_key"] = resources[0].Key
+	} else if len(resources) > 1 {
+		keys := make([]string, len(resources))
+		for i, resource := range resources {
+			keys[i] = resource.Key
+		}
+		fields["resource_keys"] = keys
+	}
+	if req, ok := req.(interface{ GetDeployment() *Deployment }); ok && req.GetDeployment() != nil {
+		fields["deployment_id"] = req.GetDeployment().Id
+	}
+	logrus.WithFields(fields).Errorf("Recovered from panic: %v\n%s", r, debug.Stack())
+	return status.Errorf(codes.Internal, "internal provider error handling %s", method)
+}
@@ -0,0 +1,62 @@
+package provider_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// panickingProvider panics in its handlers, leaking a secret in the panic value
+type panickingProvider struct {
+	provider.DefaultProviderServer
+}
+
+func (panickingProvider) DeployResource(ctx context.Context, request *provider.DeployResourceRequest) (*provider.DeployResourceReply, error) {
+	panic("password=secret")
+}
+
+func (panickingProvider) DeployResourceStream(request *provider.DeployResourceRequest, stream provider.Provider_DeployResourceStreamServer) error {
+	panic("password=secret")
+}
+
+func TestRecoveryReturnsInternalAndKeepsServing(t *testing.T) {
+	client := providertest.NewClient(t, panickingProvider{}, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	request := &provider.DeployResourceRequest{Resource: &provider.Resource{Key: "vm"}}
+
+	checkPanicError := func(err error) {
+		t.Helper()
+		if status.Code(err) != codes.Internal {
+			t.Fatalf("expected codes.Internal, got %v", err)
+		}
+		if strings.Contains(status.Convert(err).Message(), "secret") {
+			t.Errorf("expected the panic details not to be returned, got %q", status.Convert(err).Message())
+		}
+	}
+
+	_, err := client.DeployResource(ctx, request)
+	checkPanicError(err)
+
+	stream, err := client.DeployResourceStream(ctx, request)
+	if err != nil {
+		t.Fatalf("DeployResourceStream failed: %v", err)
+	}
+	_, err = stream.Recv()
+	checkPanicError(err)
+
+	// The server must keep serving after recovering
+	for i := 0; i < 2; i++ {
+		_, err = client.DeployResource(ctx, request)
+		checkPanicError(err)
+	}
+	if _, err := client.Configure(ctx, &provider.ConfigureRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected the server to keep serving other calls, got %v", err)
+	}
+}
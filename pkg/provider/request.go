@@ -0,0 +1,29 @@
+package provider
+
+// RequestResources returns the resources a request (or console frame) is for, nil if it is not
+// for any resource
+func RequestResources(req interface{}) []*Resource {
+	var candidates []*Resource
+	switch req := req.(type) {
+	case interface{ GetResource() *Resource }:
+		candidates = []*Resource{req.GetResource()}
+	case interface{ GetNewResource() *Resource }:
+		candidates = []*Resource{req.GetNewResource()}
+	case *ConsoleFrame:
+		candidates = []*Resource{req.GetOpen().GetResource()}
+	case *ExtractResourceMetadataRequest:
+		candidates = req.Resources
+	case *BulkResourcePowerRequest:
+		for _, r := range req.Requests {
+			candidates = append(candidates, r.GetResource())
+		}
+	}
+	// Requests may leave their resources unset
+	var resources []*Resource
+	for _, resource := range candidates {
+		if resource != nil {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
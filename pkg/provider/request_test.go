@@ -0,0 +1,35 @@
+package provider
+
+import "testing"
+
+func TestRequestResources(t *testing.T) {
+	vm := &Resource{Key: "vm"}
+	disk := &Resource{Key: "disk"}
+	tests := []struct {
+		name    string
+		request interface{}
+		keys    []string
+	}{
+		{"resource", &DeployResourceRequest{Resource: vm}, []string{"vm"}},
+		{"new resource", &UpdateResourceRequest{NewResource: disk}, []string{"disk"}},
+		{"console open", &ConsoleFrame{Frame: &ConsoleFrame_Open{Open: &ConsoleOpen{Resource: vm}}}, []string{"vm"}},
+		{"console data", &ConsoleFrame{Frame: &ConsoleFrame_Data{Data: []byte("data")}}, nil},
+		{"metadata", &ExtractResourceMetadataRequest{Resources: []*Resource{vm, nil, disk}}, []string{"vm", "disk"}},
+		{"bulk power", bulkPowerRequest("a", "b"), []string{"key-a", "key-b"}},
+		{"unset", &DeployResourceRequest{}, nil},
+		{"no resource", &CancelOperationRequest{}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resources := RequestResources(test.request)
+			if len(resources) != len(test.keys) {
+				t.Fatalf("expected %d resources, got %v", len(test.keys), resources)
+			}
+			for i, resource := range resources {
+				if resource.Key != test.keys[i] {
+					t.Errorf("expected resource %d to be %s, got %s", i, test.keys[i], resource.Key)
+				}
+			}
+		})
+	}
+}
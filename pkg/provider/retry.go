@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy configures how unary calls to a provider are retried. Calls are only retried when
+// the provider marks the error as retryable (see common.ProviderError), or when the error is
+// transient (e.g. codes.Unavailable) and the method is idempotent. Streaming calls are never retried
+type RetryPolicy struct {
+	// The maximum number of attempts (including the first), defaults to 3
+	MaxAttempts int
+	// The backoff before the first retry, defaults to 500 milliseconds
+	InitialBackoff time.Duration
+	// The maximum backoff between retries, defaults to 30 seconds
+	MaxBackoff time.Duration
+	// The multiplier applied to the backoff after each retry, defaults to 2
+	BackoffMultiplier float64
+	// The maximum random jitter applied to each backoff as a fraction of it (0-1)
+	Jitter float64
+	// Overrides the policy for specific methods, keyed by full method name (e.g. Provider_DeployResource_FullMethodName)
+	Methods map[string]*RetryPolicy
+	// Overrides which methods are idempotent, keyed by full method name (defaults to DefaultIdempotentMethods)
+	IdempotentMethods map[string]bool
+}
+
+// DefaultIdempotentMethods are the methods which do not modify infrastructure and are safe to retry
+// on any transient error
+var DefaultIdempotentMethods = map[string]bool{
+	Provider_Handshake_FullMethodName:               true,
+	Provider_ExtractResourceMetadata_FullMethodName: true,
+	Provider_RetrieveData_FullMethodName:            true,
+	Provider_GetConsole_FullMethodName:              true,
+	Provider_RefreshResource_FullMethodName:         true,
+	Provider_GetResourcePower_FullMethodName:        true,
+	Provider_ListSnapshots_FullMethodName:           true,
+	Provider_PlanDeployResource_FullMethodName:      true,
+	Provider_PlanDestroyResource_FullMethodName:     true,
+	Provider_CancelOperation_FullMethodName:         true,
+}
+
+// forMethod returns the policy to use for the method
+func (p *RetryPolicy) forMethod(method string) *RetryPolicy {
+	if methodPolicy, ok := p.Methods[method]; ok && methodPolicy != nil {
+		return methodPolicy
+	}
+	return p
+}
+
+// shouldRetry returns whether the failed call to the method may be retried
+func (p *RetryPolicy) shouldRetry(method string, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	// The provider explicitly said whether the call is safe to retry
+	for _, detail := range st.Details() {
+		if providerErr, ok := detail.(*common.ProviderError); ok {
+			return providerErr.Retryable
+		}
+	}
+	idempotentMethods := p.IdempotentMethods
+	if idempotentMethods == nil {
+		idempotentMethods = DefaultIdempotentMethods
+	}
+	return idempotentMethods[method] && common.IsRetryable(err)
+}
+
+// backoff returns the backoff (with jitter) before the given retry (starting at 1)
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	if backoff <= 0 {
+		backoff = 500 * time.Millisecond
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 30 * time.Second
+	}
+	multiplier := p.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	for i := 1; i < retry && backoff < maxBackoff; i++ {
+		backoff = time.Duration(float64(backoff) * multiplier)
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	if p.Jitter > 0 {
+		backoff += time.Duration(rand.Float64() * p.Jitter * float64(backoff))
+	}
+	return backoff
+}
+
+// maxAttempts returns the maximum number of attempts
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return 3
+	}
+	return p.MaxAttempts
+}
+
+// WithRetry returns the dial option retrying failed unary calls on the connection according to the
+// policy. Connect adds it when ProviderClientOptions.Retry is set. Retries happen on the connection,
+// so pass it when dialing connections for NewClient yourself (e.g. providertest.Options.DialOptions)
+func WithRetry(policy *RetryPolicy) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(RetryUnaryClientInterceptor(policy))
+}
+
+// RetryUnaryClientInterceptor retries failed unary calls according to the policy (see WithRetry)
+func RetryUnaryClientInterceptor(policy *RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methodPolicy := policy.forMethod(method)
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= methodPolicy.maxAttempts() || !policy.shouldRetry(method, err) {
+				return err
+			}
+			backoff := methodPolicy.backoff(attempt)
+			logrus.WithField("component", "PROVIDER_GRPC_CLIENT").Debugf("Attempt %d of %s failed, retrying in %s: %v", attempt, method, backoff, err)
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+			}
+		}
+	}
+}
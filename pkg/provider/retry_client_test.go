@@ -0,0 +1,51 @@
+package provider_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// flakyProvider fails the first calls to RetrieveData with codes.Unavailable
+type flakyProvider struct {
+	provider.DefaultProviderServer
+	failures int32
+	calls    *int32
+}
+
+func (p flakyProvider) RetrieveData(ctx context.Context, request *provider.RetrieveDataRequest) (*provider.RetrieveDataReply, error) {
+	if atomic.AddInt32(p.calls, 1) <= p.failures {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &provider.RetrieveDataReply{Success: true}, nil
+}
+
+func TestWithRetry(t *testing.T) {
+	var calls int32
+	client := providertest.NewClient(t, flakyProvider{failures: 2, calls: &calls}, &providertest.Options{
+		DialOptions: []grpc.DialOption{provider.WithRetry(&provider.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		})},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	reply, err := client.RetrieveData(ctx, &provider.RetrieveDataRequest{})
+	if err != nil {
+		t.Fatalf("expected RetrieveData to succeed after retrying, got %v", err)
+	}
+	if !reply.Success {
+		t.Errorf("expected a successful reply")
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
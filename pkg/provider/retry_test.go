@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := &RetryPolicy{}
+	for name, test := range map[string]struct {
+		method string
+		err    error
+		retry  bool
+	}{
+		"not a status error": {
+			method: Provider_Handshake_FullMethodName,
+			err:    errors.New("failed"),
+		},
+		"transient idempotent": {
+			method: Provider_Handshake_FullMethodName,
+			err:    status.Error(codes.Unavailable, "unavailable"),
+			retry:  true,
+		},
+		"transient non-idempotent": {
+			method: Provider_DeployResource_FullMethodName,
+			err:    status.Error(codes.Unavailable, "unavailable"),
+		},
+		"permanent idempotent": {
+			method: Provider_Handshake_FullMethodName,
+			err:    status.Error(codes.InvalidArgument, "invalid"),
+		},
+		"provider marked retryable": {
+			method: Provider_DeployResource_FullMethodName,
+			err:    common.NewProviderError(common.ErrorCategory_QUOTA, "quota").WithRetryable(true).Err(),
+			retry:  true,
+		},
+		"provider marked not retryable": {
+			method: Provider_Handshake_FullMethodName,
+			err:    common.NewProviderError(common.ErrorCategory_TRANSIENT, "busy").WithRetryable(false).Err(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if retry := policy.shouldRetry(test.method, test.err); retry != test.retry {
+				t.Errorf("expected shouldRetry to return %t, got %t", test.retry, retry)
+			}
+		})
+	}
+
+	policy = &RetryPolicy{IdempotentMethods: map[string]bool{Provider_DeployResource_FullMethodName: true}}
+	if !policy.shouldRetry(Provider_DeployResource_FullMethodName, status.Error(codes.Unavailable, "unavailable")) {
+		t.Errorf("expected overridden idempotent methods to be retried")
+	}
+	if policy.shouldRetry(Provider_Handshake_FullMethodName, status.Error(codes.Unavailable, "unavailable")) {
+		t.Errorf("expected the overridden idempotent methods to replace the defaults")
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{}
+	for retry, expected := range map[int]time.Duration{
+		1:  500 * time.Millisecond,
+		2:  time.Second,
+		3:  2 * time.Second,
+		10: 30 * time.Second,
+	} {
+		if backoff := policy.backoff(retry); backoff != expected {
+			t.Errorf("expected retry %d to back off %s, got %s", retry, expected, backoff)
+		}
+	}
+
+	policy = &RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 4 * time.Second, BackoffMultiplier: 3, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		if backoff := policy.backoff(1); backoff < time.Second || backoff > 1500*time.Millisecond {
+			t.Fatalf("expected the jittered backoff to be within 50%% of 1s, got %s", backoff)
+		}
+		if backoff := policy.backoff(3); backoff < 4*time.Second || backoff > 6*time.Second {
+			t.Fatalf("expected the jittered backoff to be within 50%% of the 4s maximum, got %s", backoff)
+		}
+	}
+}
+
+func TestRetryUnaryClientInterceptor(t *testing.T) {
+	interceptor := RetryUnaryClientInterceptor(&RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Methods: map[string]*RetryPolicy{
+			Provider_RefreshResource_FullMethodName: {MaxAttempts: 1},
+		},
+	})
+	invoke := func(method string, failures int) (int, error) {
+		attempts := 0
+		err := interceptor(context.Background(), method, nil, nil, nil, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			attempts++
+			if attempts <= failures {
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+			return nil
+		})
+		return attempts, err
+	}
+
+	if attempts, err := invoke(Provider_Handshake_FullMethodName, 2); err != nil || attempts != 3 {
+		t.Errorf("expected the call to succeed on the 3rd attempt, got %d attempts (%v)", attempts, err)
+	}
+	if attempts, err := invoke(Provider_Handshake_FullMethodName, 5); status.Code(err) != codes.Unavailable || attempts != 3 {
+		t.Errorf("expected the call to fail after 3 attempts, got %d attempts (%v)", attempts, err)
+	}
+	if attempts, _ := invoke(Provider_RefreshResource_FullMethodName, 5); attempts != 1 {
+		t.Errorf("expected the method policy to allow 1 attempt, got %d", attempts)
+	}
+	if attempts, _ := invoke(Provider_DeployResource_FullMethodName, 5); attempts != 1 {
+		t.Errorf("expected non-idempotent calls not to be retried, got %d attempts", attempts)
+	}
+}
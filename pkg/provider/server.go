@@ -2,20 +2,28 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	sync "sync"
 	"syscall"
+	"time"
 
 	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/metrics"
+	"github.com/cble-platform/cble-provider-grpc/pkg/tracing"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/mod/semver"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
+// DefaultProviderServer implements the Handshake and CancelOperation RPCs. All other RPCs (including
+// optional ones such as PlanDeployResource and PlanDestroyResource) return codes.Unimplemented unless
+// the provider overrides them
 type DefaultProviderServer struct {
 	UnimplementedProviderServer
 }
@@ -30,50 +38,196 @@ type ProviderServerOptions struct {
 	CertFile string
 	KeyFile  string
 	SocketID string
+	// The CA bundle to verify client certificates against (enables mTLS)
+	CAFile string
+	// The client certificate policy, defaults to tls.RequireAndVerifyClientCert if CAFile is set
+	ClientAuth tls.ClientAuthType
+	// The bearer token (from the RegistrationReply) CBLE must present on every call
+	AuthToken string
+	// Returns the bearer token CBLE must present, overriding AuthToken. Use it to swap the token
+	// while serving (e.g. common.NewAtomicToken updated from HeartbeatOptions.OnReregister)
+	AuthTokenSource common.TokenSource
+	// The network to listen on ("unix", "tcp", "tcp4" or "tcp6"), defaults to "unix"
+	Network string
+	// The address to listen on, defaults to the socket path of SocketID for "unix"
+	Address string
+	// How long to wait for in-flight calls on shutdown before forcing a stop, defaults to 30 seconds
+	DrainTimeout time.Duration
+	// Interceptors to run (in order) after authentication and before the provider handlers
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+	// Any additional options to pass to grpc.NewServer
+	ExtraOptions []grpc.ServerOption
+	// Records Prometheus metrics for every call if set
+	Metrics *metrics.Metrics
+	// The name of the provider to label metrics with
+	ProviderName string
+	// The address to serve the Prometheus /metrics endpoint on (e.g. ":9100"), requires Metrics
+	MetricsAddress string
 }
 
-// Serve is a blocking call which returns an error if unable to serve
+const defaultDrainTimeout = 30 * time.Second
+
+// Serve is a blocking call which returns an error if unable to serve. The server is gracefully
+// stopped on SIGINT/SIGTERM (use ServeContext to handle signals in the embedding program instead)
 func Serve(provider ProviderServer, options *ProviderServerOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Setup graceful shutdown signals
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	go func() {
+		select {
+		case s := <-sigCh:
+			logrus.Warnf("Received signal %v, attempting graceful shutdown...", s)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ServeContext(ctx, provider, options)
+}
+
+// ServeContext is a blocking call which returns an error if unable to serve. The server is gracefully
+// stopped once the context is cancelled, forcing a stop if in-flight calls do not complete within
+// the drain timeout, and the unix socket (if any) is removed
+func ServeContext(ctx context.Context, provider ProviderServer, options *ProviderServerOptions) error {
 	if options == nil {
 		return fmt.Errorf("options must not be nil")
 	}
-	lis, err := net.Listen("unix", fmt.Sprintf("/tmp/cble-provider-grpc-%s", options.SocketID))
+	network, address, err := resolveAddress(options.Network, options.Address, options.SocketID)
+	if err != nil {
+		return err
+	}
+	grpcServer, err := NewServer(provider, options)
+	if err != nil {
+		return err
+	}
+	lis, err := net.Listen(network, address)
 	if err != nil {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
-	var opts []grpc.ServerOption
-	if options.TLS {
-		if options.CertFile == "" || options.KeyFile == "" {
-			return fmt.Errorf("must provider a certificate and key file if using TLS")
-		}
-		creds, err := credentials.NewServerTLSFromFile(options.CertFile, options.KeyFile)
-		if err != nil {
-			return err
+
+	// Serve the metrics endpoint alongside the gRPC server
+	var metricsServer *http.Server
+	if options.MetricsAddress != "" {
+		if options.Metrics == nil {
+			lis.Close()
+			return fmt.Errorf("metrics must be set to serve the metrics endpoint")
 		}
-		opts = []grpc.ServerOption{grpc.Creds(creds)}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", options.Metrics.Handler())
+		metricsServer = &http.Server{Addr: options.MetricsAddress, Handler: mux}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logrus.Errorf("failed to serve metrics: %v", err)
+			}
+		}()
 	}
-	grpcServer := grpc.NewServer(opts...)
-	RegisterProviderServer(grpcServer, provider)
 
-	// Setup graceful shutdown signals
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	drainTimeout := options.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = defaultDrainTimeout
+	}
+
+	// Setup graceful shutdown on context cancellation (or once Serve fails)
+	serveCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
-		s := <-sigCh
-		logrus.Warnf("Received signal %v, attempting graceful shutdown...", s)
-		grpcServer.GracefulStop()
-		wg.Done()
+		defer wg.Done()
+		<-serveCtx.Done()
+		// Stop the gRPC server, forcing a stop after the drain timeout
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(drainTimeout):
+			logrus.Warnf("In-flight calls did not complete within %s, forcing shutdown...", drainTimeout)
+			grpcServer.Stop()
+		}
+		// Stop the metrics server
+		if metricsServer != nil {
+			if err := metricsServer.Close(); err != nil {
+				logrus.Warnf("failed to stop metrics server: %v", err)
+			}
+		}
+		// Cleanup the socket file
+		if network == "unix" {
+			if err := os.Remove(address); err != nil && !os.IsNotExist(err) {
+				logrus.Warnf("failed to cleanup socket %s: %v", address, err)
+			}
+		}
 	}()
 
-	if err := grpcServer.Serve(lis); err != nil {
+	err = grpcServer.Serve(lis)
+	// Make sure the server is stopped and cleaned up if Serve returned on its own
+	cancel()
+	wg.Wait()
+	// The server may already be stopped if the context was cancelled before serving
+	if err != nil && err != grpc.ErrServerStopped {
 		return err
 	}
-	wg.Wait()
 	return nil
 }
 
+// NewServer returns a gRPC server with the provider registered and all options applied (TLS, auth,
+// interceptors, ...) without listening on anything. Use it to serve the provider on a custom listener
+func NewServer(provider ProviderServer, options *ProviderServerOptions) (*grpc.Server, error) {
+	var opts []grpc.ServerOption
+	if options.TLS {
+		tlsConfig, err := common.NewServerTLSConfig(options.CertFile, options.KeyFile, options.CAFile, options.ClientAuth)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if options.Metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, options.Metrics.UnaryServerInterceptor(options.ProviderName))
+		streamInterceptors = append(streamInterceptors, options.Metrics.StreamServerInterceptor(options.ProviderName))
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		tracing.UnaryServerInterceptor(requestAttributes),
+		RecoveryUnaryInterceptor,
+		common.PeerIdentityUnaryInterceptor,
+	)
+	streamInterceptors = append(streamInterceptors,
+		tracing.StreamServerInterceptor(requestAttributes),
+		RecoveryStreamInterceptor,
+		common.PeerIdentityStreamInterceptor,
+	)
+	tokenSource := options.AuthTokenSource
+	if tokenSource == nil && options.AuthToken != "" {
+		tokenSource = common.StaticToken(options.AuthToken)
+	}
+	if tokenSource != nil {
+		unaryInterceptors = append(unaryInterceptors, common.TokenAuthUnaryInterceptor(tokenSource))
+		streamInterceptors = append(streamInterceptors, common.TokenAuthStreamInterceptor(tokenSource))
+	}
+	unaryInterceptors = append(unaryInterceptors, options.UnaryInterceptors...)
+	streamInterceptors = append(streamInterceptors, options.StreamInterceptors...)
+	// Operations are tracked per server so they can only be cancelled through the server serving them
+	operations := newOperationRegistry()
+	unaryInterceptors = append(unaryInterceptors, operationUnaryInterceptor(operations), powerValidationUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, operationStreamInterceptor(operations))
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	opts = append(opts, options.ExtraOptions...)
+	grpcServer := grpc.NewServer(opts...)
+	RegisterProviderServer(grpcServer, provider)
+	return grpcServer, nil
+}
+
 func (DefaultProviderServer) Handshake(ctx context.Context, request *common.HandshakeRequest) (*common.HandshakeReply, error) {
 	if semver.Major(request.ClientVersion) != semver.Major(VERSION) {
 		return nil, fmt.Errorf("major version mismatch: server version is %s and client version is %s", VERSION, request.ClientVersion)
@@ -83,3 +237,20 @@ func (DefaultProviderServer) Handshake(ctx context.Context, request *common.Hand
 		ServerVersion: VERSION,
 	}, nil
 }
+
+// CancelOperation cancels the context of an in-flight deploy/destroy operation served by this server.
+// Providers should watch for the cancellation in their handlers and roll back any partial changes
+func (DefaultProviderServer) CancelOperation(ctx context.Context, request *CancelOperationRequest) (*CancelOperationReply, error) {
+	operations, ok := operationRegistryFromContext(ctx)
+	if !ok || !operations.cancel(request.OperationId) {
+		errStr := fmt.Sprintf("no in-flight operation with ID %s", request.OperationId)
+		return &CancelOperationReply{
+			Success: false,
+			Error:   &errStr,
+		}, nil
+	}
+	logrus.Debugf("Cancelled operation %s", request.OperationId)
+	return &CancelOperationReply{
+		Success: true,
+	}, nil
+}
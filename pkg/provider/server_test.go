@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestServeContextAlreadyCancelled(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "provider.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ServeContext(ctx, DefaultProviderServer{}, &ProviderServerOptions{Address: socket})
+	}()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Errorf("expected ServeContext to return nil, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ServeContext did not return")
+	}
+	if _, err := os.Stat(socket); !os.IsNotExist(err) {
+		t.Errorf("expected the socket to be removed, got %v", err)
+	}
+}
+
+func TestServeContextCancelled(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "provider.sock")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ServeContext(ctx, DefaultProviderServer{}, &ProviderServerOptions{Address: socket})
+	}()
+	for i := 0; ; i++ {
+		if _, err := os.Stat(socket); err == nil {
+			break
+		} else if i == 500 {
+			t.Fatalf("socket was not created: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Errorf("expected ServeContext to return nil, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ServeContext did not return")
+	}
+	if _, err := os.Stat(socket); !os.IsNotExist(err) {
+		t.Errorf("expected the socket to be removed, got %v", err)
+	}
+}
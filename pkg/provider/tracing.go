@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// requestAttributes returns the span attributes identifying the deployment and resource of a request
+func requestAttributes(req interface{}) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	if req, ok := req.(interface{ GetDeployment() *Deployment }); ok && req.GetDeployment() != nil {
+		attributes = append(attributes, attribute.String("cble.deployment.id", req.GetDeployment().Id))
+	}
+	// Requests for several resources are not attributed to any single one
+	if resources := RequestResources(req); len(resources) == 1 {
+		attributes = append(attributes,
+			attribute.String("cble.resource.key", resources[0].Key),
+			attribute.String("cble.resource.id", resources[0].Id),
+		)
+	}
+	return attributes
+}
@@ -0,0 +1,568 @@
+package providertest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/google/uuid"
+	"golang.org/x/mod/semver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type Fixtures struct {
+	// The config passed to Configure before any other calls (Configure is skipped if nil)
+	Config []byte
+	// The deployment the resources belong to, defaults to a deployment with a random ID
+	Deployment *provider.Deployment
+	// The resources to run the suite against, ordered so dependencies come before their dependents.
+	// Resources without an ID are given a random one
+	Resources []*provider.Resource
+	// The initial vars of each resource, keyed by Resource.key
+	Vars map[string]map[string]string
+	// The backend identifiers of the deployed resources to import, keyed by Resource.key. Resources
+	// without one are imported with an unknown identifier, which must not succeed
+	BackendIDs map[string]string
+	// The timeout for each call, defaults to 5 minutes
+	Timeout time.Duration
+	// The options to serve the provider with
+	Options *Options
+}
+
+// conformance holds the state shared between the steps of the suite
+type conformance struct {
+	client     provider.ProviderClient
+	fixtures   *Fixtures
+	deployment *provider.Deployment
+	resources  []*provider.Resource
+	metadata   map[string]*provider.Metadata
+	// The vars of each deployed resource, keyed by Resource.key
+	deployed map[string]map[string]string
+}
+
+// RunConformance runs the provider in-process and checks it against the Provider service contract:
+//   - the handshake succeeds with a compatible server version
+//   - ExtractResourceMetadata returns a Metadata entry for every resource key
+//   - every resource deploys and then destroys successfully, using DeployResourceStream (whose last
+//     message must carry the result) unless it returns codes.Unimplemented
+//   - optional RPCs (plans, update, refresh, import and bulk power) either succeed or return
+//     codes.Unimplemented
+//   - calls for features a resource does not support return codes.Unimplemented
+//   - replies which are not successful carry an error message
+//
+// Each check runs as a subtest and violations are reported as test errors
+func RunConformance(t *testing.T, server provider.ProviderServer, fixtures *Fixtures) {
+	t.Helper()
+	if fixtures == nil {
+		fixtures = &Fixtures{}
+	}
+	c := &conformance{
+		client:     provider.NewProviderClient(Connect(t, server, fixtures.Options)),
+		fixtures:   fixtures,
+		deployment: fixtures.Deployment,
+		metadata:   make(map[string]*provider.Metadata),
+		deployed:   make(map[string]map[string]string),
+	}
+	if c.deployment == nil {
+		c.deployment = &provider.Deployment{Id: uuid.New().String()}
+	}
+	for _, resource := range fixtures.Resources {
+		// Copy the resources so the fixtures are left untouched
+		resource = proto.Clone(resource).(*provider.Resource)
+		if resource.Id == "" {
+			resource.Id = uuid.New().String()
+		}
+		c.resources = append(c.resources, resource)
+	}
+
+	if !t.Run("Handshake", c.testHandshake) {
+		// Nothing else can work without a handshake
+		return
+	}
+	if fixtures.Config != nil && !t.Run("Configure", c.testConfigure) {
+		return
+	}
+	t.Run("ExtractResourceMetadata", c.testExtractResourceMetadata)
+	t.Run("CancelOperation", c.testCancelOperation)
+
+	// Deploy in order, stopping at the first failure as dependents cannot be deployed
+	var deployed []*provider.Resource
+	for _, resource := range c.resources {
+		t.Run("PlanDeployResource/"+resource.Key, func(t *testing.T) { c.testPlanDeployResource(t, resource) })
+		if !t.Run("DeployResource/"+resource.Key, func(t *testing.T) { c.testDeployResource(t, resource) }) {
+			break
+		}
+		deployed = append(deployed, resource)
+	}
+	for _, resource := range deployed {
+		t.Run("UnsupportedFeatures/"+resource.Key, func(t *testing.T) { c.testUnsupportedFeatures(t, resource) })
+		t.Run("RefreshResource/"+resource.Key, func(t *testing.T) { c.testRefreshResource(t, resource) })
+		t.Run("UpdateResource/"+resource.Key, func(t *testing.T) { c.testUpdateResource(t, resource) })
+		t.Run("ImportResource/"+resource.Key, func(t *testing.T) { c.testImportResource(t, resource) })
+		t.Run("Power/"+resource.Key, func(t *testing.T) { c.testPower(t, resource) })
+	}
+	// Destroy in reverse order so dependents are destroyed before their dependencies
+	for i := len(deployed) - 1; i >= 0; i-- {
+		resource := deployed[i]
+		t.Run("PlanDestroyResource/"+resource.Key, func(t *testing.T) { c.testPlanDestroyResource(t, resource) })
+		t.Run("DestroyResource/"+resource.Key, func(t *testing.T) { c.testDestroyResource(t, resource) })
+	}
+}
+
+// callContext returns a context with the call timeout
+func (c *conformance) callContext() (context.Context, context.CancelFunc) {
+	timeout := c.fixtures.Timeout
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// checkReply reports an unsuccessful reply, or one which failed without an error message
+func checkReply(t *testing.T, method string, success bool, errStr *string) bool {
+	t.Helper()
+	if success {
+		return true
+	}
+	if errStr == nil || *errStr == "" {
+		t.Errorf("%s was not successful and did not set an error", method)
+	} else {
+		t.Errorf("%s was not successful: %s", method, *errStr)
+	}
+	return false
+}
+
+// checkUnimplemented reports calls which do not fail with codes.Unimplemented
+func checkUnimplemented(t *testing.T, method string, err error) {
+	t.Helper()
+	if code := status.Code(err); code != codes.Unimplemented {
+		t.Errorf("%s must return %s for an unsupported feature, got %s (%v)", method, codes.Unimplemented, code, err)
+	}
+}
+
+// checkOptional reports calls to optional RPCs which fail with anything but codes.Unimplemented, and
+// returns whether the call succeeded
+func checkOptional(t *testing.T, method string, err error) bool {
+	t.Helper()
+	if err == nil {
+		return true
+	}
+	if code := status.Code(err); code != codes.Unimplemented {
+		t.Errorf("%s must succeed or return %s, got %s (%v)", method, codes.Unimplemented, code, err)
+	}
+	return false
+}
+
+func (c *conformance) testHandshake(t *testing.T) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.Handshake(ctx, &common.HandshakeRequest{
+		ClientVersion: provider.VERSION,
+	})
+	if err != nil {
+		t.Fatalf("Handshake failed: %v", err)
+	}
+	if !semver.IsValid(semverString(reply.ServerVersion)) {
+		t.Errorf("Handshake returned invalid server version %q", reply.ServerVersion)
+	} else if semver.Major(semverString(reply.ServerVersion)) != semver.Major(semverString(provider.VERSION)) {
+		t.Errorf("Handshake returned server version %s which is incompatible with client version %s", reply.ServerVersion, provider.VERSION)
+	}
+}
+
+// semverString returns the version with the "v" prefix expected by the semver package
+func semverString(version string) string {
+	return "v" + strings.TrimPrefix(version, "v")
+}
+
+func (c *conformance) testConfigure(t *testing.T) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.Configure(ctx, &provider.ConfigureRequest{
+		Config: c.fixtures.Config,
+	})
+	if err != nil {
+		t.Fatalf("Configure failed: %v", err)
+	}
+	if !reply.Success {
+		t.Errorf("Configure was not successful")
+	}
+}
+
+func (c *conformance) testExtractResourceMetadata(t *testing.T) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.ExtractResourceMetadata(ctx, &provider.ExtractResourceMetadataRequest{
+		Resources: c.resources,
+	})
+	if err != nil {
+		t.Fatalf("ExtractResourceMetadata failed: %v", err)
+	}
+	if !checkReply(t, "ExtractResourceMetadata", reply.Success, reply.Error) {
+		return
+	}
+	keys := make(map[string]bool)
+	for _, resource := range c.resources {
+		keys[resource.Key] = true
+		metadata, ok := reply.Metadata[resource.Key]
+		if !ok || metadata == nil {
+			t.Errorf("ExtractResourceMetadata did not return metadata for resource %s", resource.Key)
+			continue
+		}
+		c.metadata[resource.Key] = metadata
+	}
+	for key := range reply.Metadata {
+		if !keys[key] {
+			t.Errorf("ExtractResourceMetadata returned metadata for unknown resource %s", key)
+		}
+	}
+}
+
+func (c *conformance) testCancelOperation(t *testing.T) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.CancelOperation(ctx, &provider.CancelOperationRequest{
+		OperationId: uuid.New().String(),
+	})
+	if err != nil {
+		t.Fatalf("CancelOperation failed: %v", err)
+	}
+	if reply.Success {
+		t.Errorf("CancelOperation succeeded for an unknown operation")
+	} else if reply.Error == nil || *reply.Error == "" {
+		t.Errorf("CancelOperation was not successful and did not set an error")
+	}
+}
+
+// deployRequest returns the request to deploy the resource with its fixture vars and the vars of its
+// (already deployed) dependencies
+func (c *conformance) deployRequest(t *testing.T, resource *provider.Resource) *provider.DeployResourceRequest {
+	t.Helper()
+	vars := make(map[string]string)
+	for k, v := range c.fixtures.Vars[resource.Key] {
+		vars[k] = v
+	}
+	dependencyVars := make(map[string]*provider.DependencyVars)
+	for _, key := range c.metadata[resource.Key].GetDependsOnKeys() {
+		depVars, ok := c.deployed[key]
+		if !ok {
+			t.Fatalf("dependency %s of resource %s was not deployed first", key, resource.Key)
+		}
+		dependencyVars[key] = &provider.DependencyVars{Vars: depVars}
+	}
+	return &provider.DeployResourceRequest{
+		Deployment:     c.deployment,
+		Resource:       resource,
+		Vars:           vars,
+		DependencyVars: dependencyVars,
+	}
+}
+
+func (c *conformance) testPlanDeployResource(t *testing.T, resource *provider.Resource) {
+	request := c.deployRequest(t, resource)
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.PlanDeployResource(ctx, request)
+	if !checkOptional(t, "PlanDeployResource", err) {
+		return
+	}
+	checkReply(t, "PlanDeployResource", reply.Success, reply.Error)
+}
+
+func (c *conformance) testDeployResource(t *testing.T, resource *provider.Resource) {
+	request := c.deployRequest(t, resource)
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	// Prefer streaming the deploy, falling back to DeployResource if it is not implemented
+	vars := request.Vars
+	reply, ok := c.deployResourceStream(ctx, t, request, vars)
+	if !ok {
+		var err error
+		reply, err = c.client.DeployResource(ctx, request)
+		if err != nil {
+			t.Fatalf("DeployResource failed: %v", err)
+		}
+	}
+	if !checkReply(t, "DeployResource", reply.Success, reply.Error) {
+		return
+	}
+	for k, v := range reply.UpdatedVars {
+		vars[k] = v
+	}
+	c.deployed[resource.Key] = vars
+}
+
+// deployResourceStream deploys the resource with DeployResourceStream, merging the partial vars of
+// each progress message into vars. It returns the result carried by the last message, or false if
+// DeployResourceStream is not implemented
+func (c *conformance) deployResourceStream(ctx context.Context, t *testing.T, request *provider.DeployResourceRequest, vars map[string]string) (*provider.DeployResourceReply, bool) {
+	t.Helper()
+	stream, err := c.client.DeployResourceStream(ctx, request)
+	if !checkOptional(t, "DeployResourceStream", err) {
+		return nil, false
+	}
+	var last *provider.DeployResourceProgress
+	for {
+		progress, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Unimplemented streams only fail once the first message is received
+			if last == nil && status.Code(err) == codes.Unimplemented {
+				return nil, false
+			}
+			t.Fatalf("DeployResourceStream failed: %v", err)
+		}
+		if last.GetResult() != nil {
+			t.Errorf("DeployResourceStream sent progress after its result")
+		}
+		for k, v := range progress.UpdatedVars {
+			vars[k] = v
+		}
+		last = progress
+	}
+	if last.GetResult() == nil {
+		t.Fatalf("DeployResourceStream ended without a result on its last message")
+	}
+	return last.Result, true
+}
+
+func (c *conformance) testRefreshResource(t *testing.T, resource *provider.Resource) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.RefreshResource(ctx, &provider.RefreshResourceRequest{
+		Deployment: c.deployment,
+		Resource:   resource,
+		Vars:       c.deployed[resource.Key],
+	})
+	if !checkOptional(t, "RefreshResource", err) {
+		return
+	}
+	if !checkReply(t, "RefreshResource", reply.Success, reply.Error) {
+		return
+	}
+	if reply.Drifted != (len(reply.Diffs) > 0) {
+		t.Errorf("RefreshResource returned drifted=%t with %d diffs", reply.Drifted, len(reply.Diffs))
+	}
+}
+
+func (c *conformance) testUpdateResource(t *testing.T, resource *provider.Resource) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	// Updating the resource to itself must be a successful in-place no-op
+	reply, err := c.client.UpdateResource(ctx, &provider.UpdateResourceRequest{
+		Deployment:  c.deployment,
+		OldResource: resource,
+		NewResource: resource,
+		Vars:        c.deployed[resource.Key],
+	})
+	if !checkOptional(t, "UpdateResource", err) {
+		return
+	}
+	if !checkReply(t, "UpdateResource", reply.Success, reply.Error) {
+		return
+	}
+	if reply.RequiresReplacement {
+		t.Errorf("UpdateResource requires replacement for an unchanged resource")
+		return
+	}
+	for k, v := range reply.UpdatedVars {
+		c.deployed[resource.Key][k] = v
+	}
+}
+
+func (c *conformance) testImportResource(t *testing.T, resource *provider.Resource) {
+	backendID, known := c.fixtures.BackendIDs[resource.Key]
+	if !known {
+		backendID = uuid.New().String()
+	}
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.ImportResource(ctx, &provider.ImportResourceRequest{
+		Deployment: c.deployment,
+		Resource:   resource,
+		BackendId:  backendID,
+	})
+	if !known {
+		// Importing an unknown object must fail (or not be implemented at all)
+		if err == nil && reply.Success {
+			t.Errorf("ImportResource succeeded for unknown backend object %s", backendID)
+		} else if err == nil && reply.GetError() == "" {
+			t.Errorf("ImportResource was not successful and did not set an error")
+		}
+		return
+	}
+	if !checkOptional(t, "ImportResource", err) {
+		return
+	}
+	checkReply(t, "ImportResource", reply.Success, reply.Error)
+}
+
+func (c *conformance) testPower(t *testing.T, resource *provider.Resource) {
+	supported := c.metadata[resource.Key].GetFeatures().GetPower()
+	vars := c.deployed[resource.Key]
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	// Request the current power state so the bulk call does not change anything
+	state := provider.PowerState_ON
+	if supported {
+		reply, err := c.client.GetResourcePower(ctx, &provider.GetResourcePowerRequest{
+			Resource: resource,
+			Vars:     vars,
+		})
+		if err != nil {
+			t.Fatalf("GetResourcePower failed: %v", err)
+		}
+		if !checkReply(t, "GetResourcePower", reply.Success, reply.Error) {
+			return
+		}
+		if reply.State == provider.PowerState_UNSPECIFIED {
+			t.Errorf("GetResourcePower did not return a power state")
+			return
+		}
+		state = reply.State
+	}
+
+	reply, err := c.client.BulkResourcePower(ctx, &provider.BulkResourcePowerRequest{
+		Requests: []*provider.ResourcePowerRequest{{
+			Resource: resource,
+			Vars:     vars,
+			State:    state,
+		}},
+	})
+	if !checkOptional(t, "BulkResourcePower", err) {
+		return
+	}
+	result, ok := reply.Results[resource.Id]
+	if !ok {
+		t.Fatalf("BulkResourcePower did not return a result for resource %s", resource.Id)
+	}
+	if supported {
+		checkReply(t, "BulkResourcePower", reply.Success, reply.Error)
+		checkReply(t, "BulkResourcePower result", result.Success, result.Error)
+	} else if result.Success {
+		t.Errorf("BulkResourcePower succeeded for a resource which does not support power")
+	}
+}
+
+func (c *conformance) testUnsupportedFeatures(t *testing.T, resource *provider.Resource) {
+	metadata, ok := c.metadata[resource.Key]
+	if !ok {
+		t.Skipf("no metadata for resource %s", resource.Key)
+	}
+	features := metadata.GetFeatures()
+	vars := c.deployed[resource.Key]
+
+	ctx, cancel := c.callContext()
+	defer cancel()
+	if !features.GetPower() {
+		_, err := c.client.ResourcePower(ctx, &provider.ResourcePowerRequest{
+			Resource: resource,
+			Vars:     vars,
+			State:    provider.PowerState_ON,
+		})
+		checkUnimplemented(t, "ResourcePower", err)
+		_, err = c.client.GetResourcePower(ctx, &provider.GetResourcePowerRequest{
+			Resource: resource,
+			Vars:     vars,
+		})
+		checkUnimplemented(t, "GetResourcePower", err)
+	}
+	if !features.GetConsole() {
+		_, err := c.client.GetConsole(ctx, &provider.GetConsoleRequest{
+			Resource: resource,
+			Vars:     vars,
+		})
+		checkUnimplemented(t, "GetConsole", err)
+		checkUnimplemented(t, "ConsoleSession", c.openConsoleSession(ctx, resource, vars))
+	}
+	if !features.GetSnapshot() {
+		_, err := c.client.CreateSnapshot(ctx, &provider.CreateSnapshotRequest{
+			Resource: resource,
+			Vars:     vars,
+			Name:     "conformance",
+		})
+		checkUnimplemented(t, "CreateSnapshot", err)
+		_, err = c.client.ListSnapshots(ctx, &provider.ListSnapshotsRequest{
+			Resource: resource,
+			Vars:     vars,
+		})
+		checkUnimplemented(t, "ListSnapshots", err)
+		_, err = c.client.RevertSnapshot(ctx, &provider.RevertSnapshotRequest{
+			Resource:   resource,
+			Vars:       vars,
+			SnapshotId: uuid.New().String(),
+		})
+		checkUnimplemented(t, "RevertSnapshot", err)
+		_, err = c.client.DeleteSnapshot(ctx, &provider.DeleteSnapshotRequest{
+			Resource:   resource,
+			Vars:       vars,
+			SnapshotId: uuid.New().String(),
+		})
+		checkUnimplemented(t, "DeleteSnapshot", err)
+	}
+}
+
+// openConsoleSession opens a console session for the resource and returns the error it fails with
+func (c *conformance) openConsoleSession(ctx context.Context, resource *provider.Resource, vars map[string]string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.client.ConsoleSession(ctx)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&provider.ConsoleFrame{
+		Frame: &provider.ConsoleFrame_Open{
+			Open: &provider.ConsoleOpen{
+				Resource: resource,
+				Vars:     vars,
+			},
+		},
+	})
+	if err != nil {
+		// The real error is returned by Recv
+		_, err = stream.Recv()
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	return fmt.Errorf("console session opened")
+}
+
+func (c *conformance) testPlanDestroyResource(t *testing.T, resource *provider.Resource) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.PlanDestroyResource(ctx, &provider.DestroyResourceRequest{
+		Deployment: c.deployment,
+		Resource:   resource,
+		Vars:       c.deployed[resource.Key],
+	})
+	if !checkOptional(t, "PlanDestroyResource", err) {
+		return
+	}
+	checkReply(t, "PlanDestroyResource", reply.Success, reply.Error)
+}
+
+func (c *conformance) testDestroyResource(t *testing.T, resource *provider.Resource) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+	reply, err := c.client.DestroyResource(ctx, &provider.DestroyResourceRequest{
+		Deployment: c.deployment,
+		Resource:   resource,
+		Vars:       c.deployed[resource.Key],
+	})
+	if err != nil {
+		t.Fatalf("DestroyResource failed: %v", err)
+	}
+	checkReply(t, "DestroyResource", reply.Success, reply.Error)
+}
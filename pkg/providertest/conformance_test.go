@@ -0,0 +1,121 @@
+package providertest_test
+
+import (
+	"context"
+	"fmt"
+	sync "sync"
+	"testing"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+)
+
+// minimalProvider only implements the required RPCs
+type minimalProvider struct {
+	provider.DefaultProviderServer
+}
+
+func (minimalProvider) ExtractResourceMetadata(ctx context.Context, request *provider.ExtractResourceMetadataRequest) (*provider.ExtractResourceMetadataReply, error) {
+	metadata := make(map[string]*provider.Metadata)
+	for _, resource := range request.Resources {
+		metadata[resource.Key] = &provider.Metadata{Features: &provider.Features{}}
+	}
+	return &provider.ExtractResourceMetadataReply{Success: true, Metadata: metadata}, nil
+}
+
+func (minimalProvider) DeployResource(ctx context.Context, request *provider.DeployResourceRequest) (*provider.DeployResourceReply, error) {
+	return &provider.DeployResourceReply{Success: true, UpdatedVars: map[string]string{"id": request.Resource.Id}}, nil
+}
+
+func (minimalProvider) DestroyResource(ctx context.Context, request *provider.DestroyResourceRequest) (*provider.DestroyResourceReply, error) {
+	return &provider.DestroyResourceReply{Success: true}, nil
+}
+
+// fullProvider also implements the optional RPCs, keeping the power state of its resources in memory
+type fullProvider struct {
+	minimalProvider
+	mu    sync.Mutex
+	power map[string]provider.PowerState
+}
+
+func (p *fullProvider) ExtractResourceMetadata(ctx context.Context, request *provider.ExtractResourceMetadataRequest) (*provider.ExtractResourceMetadataReply, error) {
+	reply, err := p.minimalProvider.ExtractResourceMetadata(ctx, request)
+	for _, metadata := range reply.Metadata {
+		metadata.Features.Power = true
+	}
+	return reply, err
+}
+
+func (p *fullProvider) DeployResource(ctx context.Context, request *provider.DeployResourceRequest) (*provider.DeployResourceReply, error) {
+	p.mu.Lock()
+	p.power[request.Resource.Id] = provider.PowerState_ON
+	p.mu.Unlock()
+	return p.minimalProvider.DeployResource(ctx, request)
+}
+
+func (p *fullProvider) DeployResourceStream(request *provider.DeployResourceRequest, stream provider.Provider_DeployResourceStreamServer) error {
+	return provider.StreamDeployResource(request, stream, p.DeployResource)
+}
+
+func (p *fullProvider) PlanDeployResource(ctx context.Context, request *provider.DeployResourceRequest) (*provider.PlanResourceReply, error) {
+	return &provider.PlanResourceReply{Success: true, Changes: []*provider.PlannedChange{{Action: provider.ChangeAction_CREATE}}}, nil
+}
+
+func (p *fullProvider) PlanDestroyResource(ctx context.Context, request *provider.DestroyResourceRequest) (*provider.PlanResourceReply, error) {
+	return &provider.PlanResourceReply{Success: true, Changes: []*provider.PlannedChange{{Action: provider.ChangeAction_DELETE}}}, nil
+}
+
+func (p *fullProvider) RefreshResource(ctx context.Context, request *provider.RefreshResourceRequest) (*provider.RefreshResourceReply, error) {
+	observed := map[string]string{"id": request.Resource.Id}
+	diffs := provider.DiffVars(request.Vars, observed)
+	return &provider.RefreshResourceReply{Success: true, ObservedVars: observed, Drifted: diffs != nil, Diffs: diffs}, nil
+}
+
+func (p *fullProvider) UpdateResource(ctx context.Context, request *provider.UpdateResourceRequest) (*provider.UpdateResourceReply, error) {
+	return &provider.UpdateResourceReply{Success: true}, nil
+}
+
+func (p *fullProvider) ImportResource(ctx context.Context, request *provider.ImportResourceRequest) (*provider.ImportResourceReply, error) {
+	if request.BackendId != request.Resource.Id {
+		errStr := fmt.Sprintf("unknown backend object %s", request.BackendId)
+		return &provider.ImportResourceReply{Success: false, Error: &errStr}, nil
+	}
+	return &provider.ImportResourceReply{Success: true, UpdatedVars: map[string]string{"id": request.BackendId}}, nil
+}
+
+func (p *fullProvider) ResourcePower(ctx context.Context, request *provider.ResourcePowerRequest) (*provider.ResourcePowerReply, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.power[request.Resource.Id] = request.State
+	return &provider.ResourcePowerReply{Success: true}, nil
+}
+
+func (p *fullProvider) GetResourcePower(ctx context.Context, request *provider.GetResourcePowerRequest) (*provider.GetResourcePowerReply, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &provider.GetResourcePowerReply{Success: true, State: p.power[request.Resource.Id]}, nil
+}
+
+func (p *fullProvider) BulkResourcePower(ctx context.Context, request *provider.BulkResourcePowerRequest) (*provider.BulkResourcePowerReply, error) {
+	return provider.BulkResourcePower(ctx, request, 0, p.ResourcePower)
+}
+
+func conformanceResources() []*provider.Resource {
+	return []*provider.Resource{
+		{Id: "network", Key: "network"},
+		{Id: "vm", Key: "vm"},
+	}
+}
+
+func TestRunConformanceMinimalProvider(t *testing.T) {
+	providertest.RunConformance(t, minimalProvider{}, &providertest.Fixtures{
+		Resources: conformanceResources(),
+	})
+}
+
+func TestRunConformanceFullProvider(t *testing.T) {
+	providertest.RunConformance(t, &fullProvider{power: make(map[string]provider.PowerState)}, &providertest.Fixtures{
+		Resources:  conformanceResources(),
+		BackendIDs: map[string]string{"vm": "vm"},
+	})
+}
@@ -0,0 +1,626 @@
+package providertest
+
+import (
+	"context"
+	"io"
+	"reflect"
+	sync "sync"
+	"testing"
+	"time"
+
+	common "github.com/cble-platform/cble-provider-grpc/pkg/common"
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Matcher returns whether a request matches an expectation
+type Matcher func(request proto.Message) bool
+
+// ResourceKey matches requests for the resource key. Requests for several resources (e.g.
+// ExtractResourceMetadata) match if any of their resources has the key
+func ResourceKey(key string) Matcher {
+	return func(request proto.Message) bool {
+		for _, resource := range provider.RequestResources(request) {
+			if resource.GetKey() == key {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// DeploymentID matches requests for the deployment ID
+func DeploymentID(id string) Matcher {
+	return func(request proto.Message) bool {
+		req, ok := request.(interface{ GetDeployment() *provider.Deployment })
+		return ok && req.GetDeployment() != nil && req.GetDeployment().Id == id
+	}
+}
+
+// Expectation is a canned response to calls of a method matching all of its matchers
+type Expectation struct {
+	method   string
+	matchers []Matcher
+	reply    proto.Message
+	messages []proto.Message
+	do       func(ctx context.Context, request proto.Message) (proto.Message, error)
+	err      error
+	delay    time.Duration
+	// The number of calls the expectation applies to (0 is unlimited)
+	times int
+	// The number of calls which fail with failErr before the response is returned
+	failTimes int
+	failErr   error
+	calls     int
+}
+
+// Matching only applies the expectation to requests matching all of the matchers
+func (e *Expectation) Matching(matchers ...Matcher) *Expectation {
+	e.matchers = append(e.matchers, matchers...)
+	return e
+}
+
+// WithResourceKey only applies the expectation to requests for the resource key
+func (e *Expectation) WithResourceKey(key string) *Expectation {
+	return e.Matching(ResourceKey(key))
+}
+
+// WithDeploymentID only applies the expectation to requests for the deployment ID
+func (e *Expectation) WithDeploymentID(id string) *Expectation {
+	return e.Matching(DeploymentID(id))
+}
+
+// Return sets the reply to return. The reply must be the reply type of the method
+func (e *Expectation) Return(reply proto.Message) *Expectation {
+	e.reply = reply
+	return e
+}
+
+// ReturnStream sets the messages to send on a streaming method (DeployResourceProgress for
+// DeployResourceStream or ConsoleFrame for ConsoleSession) before the stream ends
+func (e *Expectation) ReturnStream(messages ...proto.Message) *Expectation {
+	e.messages = messages
+	return e
+}
+
+// ReturnError sets the error to return. On streaming methods the error ends the stream once all
+// messages have been received
+func (e *Expectation) ReturnError(err error) *Expectation {
+	e.err = err
+	return e
+}
+
+// Do computes the reply of unary methods with the function instead of returning a canned reply
+func (e *Expectation) Do(do func(ctx context.Context, request proto.Message) (proto.Message, error)) *Expectation {
+	e.do = do
+	return e
+}
+
+// Delay waits before each reply (or streamed message) to simulate a slow provider. Calls whose
+// context is done while waiting fail with the context error
+func (e *Expectation) Delay(delay time.Duration) *Expectation {
+	e.delay = delay
+	return e
+}
+
+// Times only applies the expectation to the next n matching calls
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+// FailTimes makes the first n matching calls fail with the error before the expectation responds
+// normally, to simulate a flaky provider. The error defaults to codes.Unavailable if nil
+func (e *Expectation) FailTimes(n int, err error) *Expectation {
+	if err == nil {
+		err = status.Error(codes.Unavailable, "mock call failed")
+	}
+	e.failTimes = n
+	e.failErr = err
+	return e
+}
+
+// Call is a call made to the mock
+type Call struct {
+	// The full method name (e.g. provider.Provider_DeployResource_FullMethodName)
+	Method string
+	// The request, or the first frame sent for ConsoleSession
+	Request proto.Message
+	// The frames sent after the first for ConsoleSession
+	Sent []proto.Message
+}
+
+// response is how the mock responds to a single call
+type response struct {
+	reply    proto.Message
+	messages []proto.Message
+	do       func(ctx context.Context, request proto.Message) (proto.Message, error)
+	err      error
+	delay    time.Duration
+}
+
+// MockProviderClient is a ProviderClient which responds to calls with canned replies and records
+// all calls made to it. Calls which match no expectation fail with codes.Unimplemented
+type MockProviderClient struct {
+	mu           sync.Mutex
+	expectations []*Expectation
+	calls        []*Call
+}
+
+var _ provider.ProviderClient = (*MockProviderClient)(nil)
+
+func NewMockProviderClient() *MockProviderClient {
+	return &MockProviderClient{}
+}
+
+// On adds an expectation for the full method name (e.g. provider.Provider_DeployResource_FullMethodName).
+// Expectations are matched in the order they were added
+func (m *MockProviderClient) On(method string) *Expectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e := &Expectation{method: method}
+	m.expectations = append(m.expectations, e)
+	return e
+}
+
+// Calls returns the calls made to the method, or all calls if method is empty
+func (m *MockProviderClient) Calls(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var calls []Call
+	for _, call := range m.calls {
+		if method == "" || call.Method == method {
+			copied := *call
+			copied.Sent = append([]proto.Message(nil), call.Sent...)
+			calls = append(calls, copied)
+		}
+	}
+	return calls
+}
+
+// AssertExpectations reports expectations which were never matched, or were matched fewer times
+// than set with Times
+func (m *MockProviderClient) AssertExpectations(t testing.TB) {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.expectations {
+		if e.calls == 0 {
+			t.Errorf("expected call to %s was never made", e.method)
+		} else if e.times > 0 && e.calls < e.times {
+			t.Errorf("expected %d calls to %s, got %d", e.times, e.method, e.calls)
+		}
+	}
+}
+
+// record records the call and returns the response of the first matching expectation
+func (m *MockProviderClient) record(method string, request proto.Message) (*Call, *response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	call := &Call{
+		Method:  method,
+		Request: proto.Clone(request),
+	}
+	m.calls = append(m.calls, call)
+
+	for _, e := range m.expectations {
+		if e.method != method || (e.times > 0 && e.calls >= e.times) {
+			continue
+		}
+		matches := true
+		for _, matcher := range e.matchers {
+			if !matcher(request) {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		e.calls++
+		if e.calls <= e.failTimes {
+			return call, &response{err: e.failErr, delay: e.delay}
+		}
+		return call, &response{
+			reply:    e.reply,
+			messages: e.messages,
+			do:       e.do,
+			err:      e.err,
+			delay:    e.delay,
+		}
+	}
+	return call, &response{err: status.Errorf(codes.Unimplemented, "no expectation matches call to %s", method)}
+}
+
+// wait blocks for the delay or until the context is done
+func wait(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// responseHeader returns the response header of a call to the method. Like the provider server,
+// operations carry their operation ID (the one requested with provider.WithOperationID if any)
+func responseHeader(ctx context.Context, method string) metadata.MD {
+	header := metadata.MD{}
+	if !provider.IsOperationMethod(method) {
+		return header
+	}
+	id := ""
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		if ids := md.Get(provider.OperationIDMetadataKey); len(ids) > 0 {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = provider.NewOperationID()
+	}
+	header.Set(provider.OperationIDMetadataKey, id)
+	return header
+}
+
+// applyCallOptions fills in the header and trailer requested with grpc.Header and grpc.Trailer
+func applyCallOptions(opts []grpc.CallOption, header metadata.MD) {
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case grpc.HeaderCallOption:
+			*opt.HeaderAddr = header
+		case grpc.TrailerCallOption:
+			*opt.TrailerAddr = metadata.MD{}
+		}
+	}
+}
+
+// invoke responds to a unary call. The zero reply is returned if the expectation has no reply
+func (m *MockProviderClient) invoke(ctx context.Context, method string, request proto.Message, opts []grpc.CallOption, zero proto.Message) (proto.Message, error) {
+	_, resp := m.record(method, request)
+	applyCallOptions(opts, responseHeader(ctx, method))
+	if err := wait(ctx, resp.delay); err != nil {
+		return nil, err
+	}
+	if resp.err != nil {
+		return nil, resp.err
+	}
+	reply := resp.reply
+	if resp.do != nil {
+		var err error
+		if reply, err = resp.do(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+	if reply == nil {
+		return zero, nil
+	}
+	if reflect.TypeOf(reply) != reflect.TypeOf(zero) {
+		return nil, status.Errorf(codes.Internal, "mock reply for %s is %T, expected %T", method, reply, zero)
+	}
+	// Copy the reply so callers can't modify the canned reply
+	return proto.Clone(reply), nil
+}
+
+// mockStream is a client stream which receives the messages of a response
+type mockStream struct {
+	ctx context.Context
+	// Closed once the response is known
+	ready chan struct{}
+
+	mu     sync.Mutex
+	resp   *response
+	header metadata.MD
+	next   int
+	// Sends a message on the stream (nil for server streams)
+	send func(msg proto.Message) error
+}
+
+// respond sets the response of the stream
+func (s *mockStream) respond(resp *response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resp = resp
+	close(s.ready)
+}
+
+// recv returns the next message of the response, then the response error or io.EOF
+func (s *mockStream) recv() (proto.Message, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, status.FromContextError(s.ctx.Err()).Err()
+	case <-s.ready:
+	}
+	s.mu.Lock()
+	resp := s.resp
+	s.mu.Unlock()
+	if err := wait(s.ctx, resp.delay); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.next < len(resp.messages) {
+		msg := resp.messages[s.next]
+		s.next++
+		return proto.Clone(msg), nil
+	}
+	if resp.err != nil {
+		return nil, resp.err
+	}
+	return nil, io.EOF
+}
+
+func (s *mockStream) Header() (metadata.MD, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, status.FromContextError(s.ctx.Err()).Err()
+	case <-s.ready:
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.header, nil
+}
+
+func (s *mockStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func (s *mockStream) CloseSend() error {
+	return nil
+}
+
+func (s *mockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockStream) SendMsg(m interface{}) error {
+	if s.send == nil {
+		return status.Error(codes.Internal, "cannot send on a server stream")
+	}
+	return s.send(m.(proto.Message))
+}
+
+func (s *mockStream) RecvMsg(m interface{}) error {
+	msg, err := s.recv()
+	if err != nil {
+		return err
+	}
+	if reflect.TypeOf(msg) != reflect.TypeOf(m) {
+		return status.Errorf(codes.Internal, "mock stream message is %T, expected %T", msg, m)
+	}
+	proto.Merge(m.(proto.Message), msg)
+	return nil
+}
+
+type mockDeployResourceStreamClient struct {
+	*mockStream
+}
+
+func (s mockDeployResourceStreamClient) Recv() (*provider.DeployResourceProgress, error) {
+	msg := &provider.DeployResourceProgress{}
+	if err := s.RecvMsg(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+type mockConsoleSessionClient struct {
+	*mockStream
+}
+
+func (s mockConsoleSessionClient) Send(frame *provider.ConsoleFrame) error {
+	return s.SendMsg(frame)
+}
+
+func (s mockConsoleSessionClient) Recv() (*provider.ConsoleFrame, error) {
+	msg := &provider.ConsoleFrame{}
+	if err := s.RecvMsg(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (m *MockProviderClient) Handshake(ctx context.Context, in *common.HandshakeRequest, opts ...grpc.CallOption) (*common.HandshakeReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_Handshake_FullMethodName, in, opts, &common.HandshakeReply{ServerVersion: provider.VERSION})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*common.HandshakeReply), nil
+}
+
+func (m *MockProviderClient) Configure(ctx context.Context, in *provider.ConfigureRequest, opts ...grpc.CallOption) (*provider.ConfigureReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_Configure_FullMethodName, in, opts, &provider.ConfigureReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.ConfigureReply), nil
+}
+
+func (m *MockProviderClient) ExtractResourceMetadata(ctx context.Context, in *provider.ExtractResourceMetadataRequest, opts ...grpc.CallOption) (*provider.ExtractResourceMetadataReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_ExtractResourceMetadata_FullMethodName, in, opts, &provider.ExtractResourceMetadataReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.ExtractResourceMetadataReply), nil
+}
+
+func (m *MockProviderClient) RetrieveData(ctx context.Context, in *provider.RetrieveDataRequest, opts ...grpc.CallOption) (*provider.RetrieveDataReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_RetrieveData_FullMethodName, in, opts, &provider.RetrieveDataReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.RetrieveDataReply), nil
+}
+
+func (m *MockProviderClient) DeployResource(ctx context.Context, in *provider.DeployResourceRequest, opts ...grpc.CallOption) (*provider.DeployResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_DeployResource_FullMethodName, in, opts, &provider.DeployResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.DeployResourceReply), nil
+}
+
+func (m *MockProviderClient) DeployResourceStream(ctx context.Context, in *provider.DeployResourceRequest, opts ...grpc.CallOption) (provider.Provider_DeployResourceStreamClient, error) {
+	stream := &mockStream{
+		ctx:    ctx,
+		ready:  make(chan struct{}),
+		header: responseHeader(ctx, provider.Provider_DeployResourceStream_FullMethodName),
+	}
+	_, resp := m.record(provider.Provider_DeployResourceStream_FullMethodName, in)
+	applyCallOptions(opts, stream.header)
+	stream.respond(resp)
+	return mockDeployResourceStreamClient{stream}, nil
+}
+
+func (m *MockProviderClient) DestroyResource(ctx context.Context, in *provider.DestroyResourceRequest, opts ...grpc.CallOption) (*provider.DestroyResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_DestroyResource_FullMethodName, in, opts, &provider.DestroyResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.DestroyResourceReply), nil
+}
+
+func (m *MockProviderClient) UpdateResource(ctx context.Context, in *provider.UpdateResourceRequest, opts ...grpc.CallOption) (*provider.UpdateResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_UpdateResource_FullMethodName, in, opts, &provider.UpdateResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.UpdateResourceReply), nil
+}
+
+func (m *MockProviderClient) RefreshResource(ctx context.Context, in *provider.RefreshResourceRequest, opts ...grpc.CallOption) (*provider.RefreshResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_RefreshResource_FullMethodName, in, opts, &provider.RefreshResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.RefreshResourceReply), nil
+}
+
+func (m *MockProviderClient) ImportResource(ctx context.Context, in *provider.ImportResourceRequest, opts ...grpc.CallOption) (*provider.ImportResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_ImportResource_FullMethodName, in, opts, &provider.ImportResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.ImportResourceReply), nil
+}
+
+func (m *MockProviderClient) PlanDeployResource(ctx context.Context, in *provider.DeployResourceRequest, opts ...grpc.CallOption) (*provider.PlanResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_PlanDeployResource_FullMethodName, in, opts, &provider.PlanResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.PlanResourceReply), nil
+}
+
+func (m *MockProviderClient) PlanDestroyResource(ctx context.Context, in *provider.DestroyResourceRequest, opts ...grpc.CallOption) (*provider.PlanResourceReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_PlanDestroyResource_FullMethodName, in, opts, &provider.PlanResourceReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.PlanResourceReply), nil
+}
+
+func (m *MockProviderClient) GetConsole(ctx context.Context, in *provider.GetConsoleRequest, opts ...grpc.CallOption) (*provider.GetConsoleReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_GetConsole_FullMethodName, in, opts, &provider.GetConsoleReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.GetConsoleReply), nil
+}
+
+// ConsoleSession returns a stream which matches expectations against the first frame sent (which
+// should be the ConsoleOpen frame), records all frames sent and receives the expectation's frames
+func (m *MockProviderClient) ConsoleSession(ctx context.Context, opts ...grpc.CallOption) (provider.Provider_ConsoleSessionClient, error) {
+	stream := &mockStream{
+		ctx:    ctx,
+		ready:  make(chan struct{}),
+		header: responseHeader(ctx, provider.Provider_ConsoleSession_FullMethodName),
+	}
+	applyCallOptions(opts, stream.header)
+	var call *Call
+	stream.send = func(msg proto.Message) error {
+		frame := proto.Clone(msg)
+		if call == nil {
+			var resp *response
+			call, resp = m.record(provider.Provider_ConsoleSession_FullMethodName, frame)
+			stream.respond(resp)
+			return nil
+		}
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		call.Sent = append(call.Sent, frame)
+		return nil
+	}
+	return mockConsoleSessionClient{stream}, nil
+}
+
+func (m *MockProviderClient) ResourcePower(ctx context.Context, in *provider.ResourcePowerRequest, opts ...grpc.CallOption) (*provider.ResourcePowerReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_ResourcePower_FullMethodName, in, opts, &provider.ResourcePowerReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.ResourcePowerReply), nil
+}
+
+func (m *MockProviderClient) GetResourcePower(ctx context.Context, in *provider.GetResourcePowerRequest, opts ...grpc.CallOption) (*provider.GetResourcePowerReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_GetResourcePower_FullMethodName, in, opts, &provider.GetResourcePowerReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.GetResourcePowerReply), nil
+}
+
+func (m *MockProviderClient) BulkResourcePower(ctx context.Context, in *provider.BulkResourcePowerRequest, opts ...grpc.CallOption) (*provider.BulkResourcePowerReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_BulkResourcePower_FullMethodName, in, opts, &provider.BulkResourcePowerReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.BulkResourcePowerReply), nil
+}
+
+func (m *MockProviderClient) CreateSnapshot(ctx context.Context, in *provider.CreateSnapshotRequest, opts ...grpc.CallOption) (*provider.CreateSnapshotReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_CreateSnapshot_FullMethodName, in, opts, &provider.CreateSnapshotReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.CreateSnapshotReply), nil
+}
+
+func (m *MockProviderClient) ListSnapshots(ctx context.Context, in *provider.ListSnapshotsRequest, opts ...grpc.CallOption) (*provider.ListSnapshotsReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_ListSnapshots_FullMethodName, in, opts, &provider.ListSnapshotsReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.ListSnapshotsReply), nil
+}
+
+func (m *MockProviderClient) RevertSnapshot(ctx context.Context, in *provider.RevertSnapshotRequest, opts ...grpc.CallOption) (*provider.RevertSnapshotReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_RevertSnapshot_FullMethodName, in, opts, &provider.RevertSnapshotReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.RevertSnapshotReply), nil
+}
+
+func (m *MockProviderClient) DeleteSnapshot(ctx context.Context, in *provider.DeleteSnapshotRequest, opts ...grpc.CallOption) (*provider.DeleteSnapshotReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_DeleteSnapshot_FullMethodName, in, opts, &provider.DeleteSnapshotReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.DeleteSnapshotReply), nil
+}
+
+func (m *MockProviderClient) CancelOperation(ctx context.Context, in *provider.CancelOperationRequest, opts ...grpc.CallOption) (*provider.CancelOperationReply, error) {
+	reply, err := m.invoke(ctx, provider.Provider_CancelOperation_FullMethodName, in, opts, &provider.CancelOperationReply{})
+	if err != nil {
+		return nil, err
+	}
+	return reply.(*provider.CancelOperationReply), nil
+}
@@ -0,0 +1,314 @@
+package providertest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cble-platform/cble-provider-grpc/pkg/provider"
+	"github.com/cble-platform/cble-provider-grpc/pkg/providertest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func deployRequest(deploymentID string, key string) *provider.DeployResourceRequest {
+	return &provider.DeployResourceRequest{
+		Deployment: &provider.Deployment{Id: deploymentID},
+		Resource:   &provider.Resource{Key: key},
+	}
+}
+
+func TestMockMatchers(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	mock.On(provider.Provider_DeployResource_FullMethodName).
+		WithDeploymentID("deployment").
+		WithResourceKey("vm").
+		Return(&provider.DeployResourceReply{Success: true, UpdatedVars: map[string]string{"matched": "vm"}})
+	mock.On(provider.Provider_DeployResource_FullMethodName).
+		Return(&provider.DeployResourceReply{Success: true, UpdatedVars: map[string]string{"matched": "any"}})
+	ctx := context.Background()
+
+	tests := []struct {
+		request *provider.DeployResourceRequest
+		matched string
+	}{
+		{deployRequest("deployment", "vm"), "vm"},
+		{deployRequest("deployment", "disk"), "any"},
+		{deployRequest("other", "vm"), "any"},
+	}
+	for _, test := range tests {
+		reply, err := mock.DeployResource(ctx, test.request)
+		if err != nil {
+			t.Fatalf("DeployResource failed: %v", err)
+		}
+		if reply.UpdatedVars["matched"] != test.matched {
+			t.Errorf("expected %v to match the %s expectation, got %s", test.request, test.matched, reply.UpdatedVars["matched"])
+		}
+	}
+
+	bulk := &provider.BulkResourcePowerRequest{Requests: []*provider.ResourcePowerRequest{
+		{Resource: &provider.Resource{Key: "disk"}},
+		{Resource: &provider.Resource{Key: "vm"}},
+	}}
+	mock.On(provider.Provider_BulkResourcePower_FullMethodName).WithResourceKey("vm").Return(&provider.BulkResourcePowerReply{Success: true})
+	if _, err := mock.BulkResourcePower(ctx, bulk); err != nil {
+		t.Errorf("expected requests for several resources to match any of their keys, got %v", err)
+	}
+
+	if _, err := mock.DestroyResource(ctx, &provider.DestroyResourceRequest{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected calls without an expectation to fail with codes.Unimplemented, got %v", err)
+	}
+	if calls := mock.Calls(provider.Provider_DeployResource_FullMethodName); len(calls) != 3 {
+		t.Errorf("expected 3 recorded DeployResource calls, got %d", len(calls))
+	}
+	if calls := mock.Calls(""); len(calls) != 5 {
+		t.Errorf("expected 5 recorded calls, got %d", len(calls))
+	}
+}
+
+func TestMockReturnsCopies(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	canned := &provider.DeployResourceReply{Success: true, UpdatedVars: map[string]string{"id": "1"}}
+	mock.On(provider.Provider_DeployResource_FullMethodName).Return(canned)
+
+	reply, err := mock.DeployResource(context.Background(), deployRequest("deployment", "vm"))
+	if err != nil {
+		t.Fatalf("DeployResource failed: %v", err)
+	}
+	reply.UpdatedVars["id"] = "modified"
+	if canned.UpdatedVars["id"] != "1" {
+		t.Errorf("expected the canned reply not to be modified by callers")
+	}
+
+	mock.On(provider.Provider_DestroyResource_FullMethodName).Return(&provider.DeployResourceReply{})
+	if _, err := mock.DestroyResource(context.Background(), &provider.DestroyResourceRequest{}); status.Code(err) != codes.Internal {
+		t.Errorf("expected a reply of the wrong type to fail with codes.Internal, got %v", err)
+	}
+}
+
+func TestMockDo(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	mock.On(provider.Provider_DeployResource_FullMethodName).Do(func(ctx context.Context, request proto.Message) (proto.Message, error) {
+		key := request.(*provider.DeployResourceRequest).Resource.Key
+		return &provider.DeployResourceReply{Success: true, UpdatedVars: map[string]string{"key": key}}, nil
+	})
+	reply, err := mock.DeployResource(context.Background(), deployRequest("deployment", "vm"))
+	if err != nil {
+		t.Fatalf("DeployResource failed: %v", err)
+	}
+	if reply.UpdatedVars["key"] != "vm" {
+		t.Errorf("expected the reply to be computed from the request, got %v", reply.UpdatedVars)
+	}
+}
+
+func TestMockTimes(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	mock.On(provider.Provider_RefreshResource_FullMethodName).Times(2).Return(&provider.RefreshResourceReply{Success: true})
+	mock.On(provider.Provider_RefreshResource_FullMethodName).Return(&provider.RefreshResourceReply{Success: false})
+	ctx := context.Background()
+
+	for i, expected := range []bool{true, true, false} {
+		reply, err := mock.RefreshResource(ctx, &provider.RefreshResourceRequest{})
+		if err != nil {
+			t.Fatalf("RefreshResource failed: %v", err)
+		}
+		if reply.Success != expected {
+			t.Errorf("expected call %d to succeed=%v, got %v", i+1, expected, reply.Success)
+		}
+	}
+	mock.AssertExpectations(t)
+
+	// Expectations matched fewer times than expected are reported
+	unmet := providertest.NewMockProviderClient()
+	unmet.On(provider.Provider_RefreshResource_FullMethodName).Times(2)
+	unmet.RefreshResource(ctx, &provider.RefreshResourceRequest{})
+	recorder := &recordingT{TB: t}
+	unmet.AssertExpectations(recorder)
+	if len(recorder.errors) != 1 {
+		t.Errorf("expected the unmet expectation to be reported, got %v", recorder.errors)
+	}
+}
+
+func TestMockFailTimes(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	custom := status.Error(codes.ResourceExhausted, "quota")
+	mock.On(provider.Provider_DeployResource_FullMethodName).WithResourceKey("custom").FailTimes(1, custom)
+	mock.On(provider.Provider_DeployResource_FullMethodName).FailTimes(2, nil).Return(&provider.DeployResourceReply{Success: true})
+	ctx := context.Background()
+
+	if _, err := mock.DeployResource(ctx, deployRequest("deployment", "custom")); !errors.Is(err, custom) {
+		t.Errorf("expected the custom error, got %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := mock.DeployResource(ctx, deployRequest("deployment", "vm")); status.Code(err) != codes.Unavailable {
+			t.Errorf("expected failure %d to default to codes.Unavailable, got %v", i+1, err)
+		}
+	}
+	reply, err := mock.DeployResource(ctx, deployRequest("deployment", "vm"))
+	if err != nil || !reply.Success {
+		t.Errorf("expected the call after the failures to succeed, got %v (%v)", reply, err)
+	}
+}
+
+func TestMockDelayCancelled(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	mock.On(provider.Provider_DeployResource_FullMethodName).Delay(time.Minute).Return(&provider.DeployResourceReply{Success: true})
+	mock.On(provider.Provider_DeployResourceStream_FullMethodName).Delay(time.Minute).ReturnStream(&provider.DeployResourceProgress{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := mock.DeployResource(ctx, deployRequest("deployment", "vm")); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("expected the delayed call to fail with codes.DeadlineExceeded, got %v", err)
+	}
+
+	streamCtx, streamCancel := context.WithCancel(context.Background())
+	stream, err := mock.DeployResourceStream(streamCtx, deployRequest("deployment", "vm"))
+	if err != nil {
+		t.Fatalf("DeployResourceStream failed: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		streamCancel()
+	}()
+	if _, err := stream.Recv(); status.Code(err) != codes.Canceled {
+		t.Errorf("expected the delayed stream to fail with codes.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected delayed calls to return once cancelled, took %s", elapsed)
+	}
+}
+
+func TestMockOperationIDHeader(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	mock.On(provider.Provider_DeployResource_FullMethodName).Return(&provider.DeployResourceReply{Success: true})
+	mock.On(provider.Provider_RefreshResource_FullMethodName).Return(&provider.RefreshResourceReply{Success: true})
+	mock.On(provider.Provider_DeployResourceStream_FullMethodName).ReturnStream(&provider.DeployResourceProgress{})
+
+	var header, trailer metadata.MD
+	ctx := provider.WithOperationID(context.Background(), "op")
+	if _, err := mock.DeployResource(ctx, deployRequest("deployment", "vm"), grpc.Header(&header), grpc.Trailer(&trailer)); err != nil {
+		t.Fatalf("DeployResource failed: %v", err)
+	}
+	if id := provider.OperationIDFromHeader(header); id != "op" {
+		t.Errorf("expected the requested operation ID in the header, got %q", id)
+	}
+	if trailer == nil {
+		t.Errorf("expected the trailer to be set")
+	}
+
+	if _, err := mock.DeployResource(context.Background(), deployRequest("deployment", "vm"), grpc.Header(&header)); err != nil {
+		t.Fatalf("DeployResource failed: %v", err)
+	}
+	if provider.OperationIDFromHeader(header) == "" {
+		t.Errorf("expected a generated operation ID in the header")
+	}
+
+	if _, err := mock.RefreshResource(ctx, &provider.RefreshResourceRequest{}, grpc.Header(&header)); err != nil {
+		t.Fatalf("RefreshResource failed: %v", err)
+	}
+	if id := provider.OperationIDFromHeader(header); id != "" {
+		t.Errorf("expected no operation ID for a call which is not an operation, got %q", id)
+	}
+
+	stream, err := mock.DeployResourceStream(ctx, deployRequest("deployment", "vm"))
+	if err != nil {
+		t.Fatalf("DeployResourceStream failed: %v", err)
+	}
+	streamHeader, err := stream.Header()
+	if err != nil {
+		t.Fatalf("failed to get the stream header: %v", err)
+	}
+	if id := provider.OperationIDFromHeader(streamHeader); id != "op" {
+		t.Errorf("expected the requested operation ID in the stream header, got %q", id)
+	}
+}
+
+func TestMockDeployResourceStream(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	failure := status.Error(codes.Aborted, "failed")
+	mock.On(provider.Provider_DeployResourceStream_FullMethodName).
+		ReturnStream(
+			&provider.DeployResourceProgress{Phase: "creating", Percent: 50},
+			&provider.DeployResourceProgress{Phase: "done", Percent: 100},
+		).
+		ReturnError(failure)
+
+	stream, err := mock.DeployResourceStream(context.Background(), deployRequest("deployment", "vm"))
+	if err != nil {
+		t.Fatalf("DeployResourceStream failed: %v", err)
+	}
+	for _, phase := range []string{"creating", "done"} {
+		progress, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("failed to receive progress: %v", err)
+		}
+		if progress.Phase != phase {
+			t.Errorf("expected phase %s, got %s", phase, progress.Phase)
+		}
+	}
+	if _, err := stream.Recv(); !errors.Is(err, failure) {
+		t.Errorf("expected the stream to end with the error, got %v", err)
+	}
+	if err := stream.SendMsg(&provider.DeployResourceRequest{}); status.Code(err) != codes.Internal {
+		t.Errorf("expected sending on a server stream to fail, got %v", err)
+	}
+}
+
+func TestMockConsoleSession(t *testing.T) {
+	mock := providertest.NewMockProviderClient()
+	mock.On(provider.Provider_ConsoleSession_FullMethodName).
+		WithResourceKey("vm").
+		ReturnStream(&provider.ConsoleFrame{Frame: &provider.ConsoleFrame_Data{Data: []byte("banner")}})
+
+	stream, err := mock.ConsoleSession(context.Background())
+	if err != nil {
+		t.Fatalf("ConsoleSession failed: %v", err)
+	}
+	open := &provider.ConsoleFrame{Frame: &provider.ConsoleFrame_Open{Open: &provider.ConsoleOpen{Resource: &provider.Resource{Key: "vm"}}}}
+	if err := stream.Send(open); err != nil {
+		t.Fatalf("failed to send open frame: %v", err)
+	}
+	if err := stream.Send(&provider.ConsoleFrame{Frame: &provider.ConsoleFrame_Data{Data: []byte("input")}}); err != nil {
+		t.Fatalf("failed to send data frame: %v", err)
+	}
+
+	frame, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("failed to receive frame: %v", err)
+	}
+	if string(frame.GetData()) != "banner" {
+		t.Errorf("expected the banner frame, got %v", frame)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("expected the stream to end with io.EOF, got %v", err)
+	}
+
+	calls := mock.Calls(provider.Provider_ConsoleSession_FullMethodName)
+	if len(calls) != 1 {
+		t.Fatalf("expected 1 console session, got %d", len(calls))
+	}
+	if !proto.Equal(calls[0].Request, open) {
+		t.Errorf("expected the open frame to be recorded as the request, got %v", calls[0].Request)
+	}
+	if len(calls[0].Sent) != 1 || string(calls[0].Sent[0].(*provider.ConsoleFrame).GetData()) != "input" {
+		t.Errorf("expected the data frame to be recorded, got %v", calls[0].Sent)
+	}
+}
+
+// recordingT records errors instead of failing the test
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, format)
+}
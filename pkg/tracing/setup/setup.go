@@ -0,0 +1,77 @@
+// Package setup configures the OpenTelemetry SDK for tracing. It is kept apart from package tracing
+// so importing the provider and CBLE packages only pulls in the OpenTelemetry API
+package setup
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+type Options struct {
+	// The name of the service to report in traces (e.g. the provider name)
+	ServiceName string
+	// The exporter to use (ExporterOTLP or ExporterStdout)
+	Exporter string
+	// The OTLP gRPC collector endpoint, defaults to localhost:4317
+	OTLPEndpoint string
+	// Whether to connect to the OTLP collector without TLS
+	OTLPInsecure bool
+}
+
+// Setup configures the global TracerProvider to export spans with the chosen exporter and the global
+// propagator to use W3C trace context and baggage. The returned function flushes and shuts down the
+// TracerProvider and should be called before the program exits
+func Setup(ctx context.Context, options *Options) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch options.Exporter {
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{}
+		if options.OTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(options.OTLPEndpoint))
+		}
+		if options.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unsupported exporter %s", options.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s exporter: %v", options.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(options.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %v", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	return tracerProvider.Shutdown, nil
+}
@@ -0,0 +1,203 @@
+// Package tracing propagates OpenTelemetry trace context between CBLE and providers. Spans use the
+// global TracerProvider and propagator, which are no-ops until configured (see setup.Setup)
+package tracing
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tracerName = "github.com/cble-platform/cble-provider-grpc"
+
+// AttributesFunc returns the span attributes for a request message
+type AttributesFunc func(req interface{}) []attribute.KeyValue
+
+// metadataCarrier adapts gRPC metadata to an OpenTelemetry TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// startSpan starts a span for the call with the request attributes (if any)
+func startSpan(ctx context.Context, method string, kind trace.SpanKind, req interface{}, attributes AttributesFunc) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, method, trace.WithSpanKind(kind), trace.WithAttributes(
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.method", method),
+	))
+	if req != nil && attributes != nil {
+		span.SetAttributes(attributes(req)...)
+	}
+	return ctx, span
+}
+
+// endSpan records the outcome of the call (including unsuccessful replies) and ends the span
+func endSpan(span trace.Span, reply interface{}, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	} else if reply, ok := reply.(interface {
+		GetSuccess() bool
+		GetError() string
+	}); ok && !reply.GetSuccess() {
+		span.SetStatus(otelcodes.Error, reply.GetError())
+	}
+	span.End()
+}
+
+// extract returns a copy of the context with the trace context of the incoming metadata
+func extract(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// inject returns a copy of the context with the trace context added to the outgoing metadata
+func inject(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// UnaryServerInterceptor continues the caller's trace with a server span for every unary call
+func UnaryServerInterceptor(attributes AttributesFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (reply interface{}, err error) {
+		ctx, span := startSpan(extract(ctx), info.FullMethod, trace.SpanKindServer, req, attributes)
+		defer func() {
+			endSpan(span, reply, err)
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor continues the caller's trace with a server span for every streaming call.
+// Attributes are added from the first message received on the stream
+func StreamServerInterceptor(attributes AttributesFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, span := startSpan(extract(ss.Context()), info.FullMethod, trace.SpanKindServer, nil, attributes)
+		defer func() {
+			endSpan(span, nil, err)
+		}()
+		return handler(srv, &tracedServerStream{ServerStream: ss, ctx: ctx, span: span, attributes: attributes})
+	}
+}
+
+// tracedServerStream carries the span context and adds attributes from the first message
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	span       trace.Span
+	attributes AttributesFunc
+	once       sync.Once
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tracedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.attributes != nil {
+		s.once.Do(func() {
+			s.span.SetAttributes(s.attributes(m)...)
+		})
+	}
+	return nil
+}
+
+// tracedClientConn starts a client span for every call and propagates it to the server
+type tracedClientConn struct {
+	conn       grpc.ClientConnInterface
+	attributes AttributesFunc
+}
+
+// ClientConn wraps a client connection so every call made with it is traced and its trace context
+// is propagated to the server
+func ClientConn(conn grpc.ClientConnInterface, attributes AttributesFunc) grpc.ClientConnInterface {
+	return &tracedClientConn{conn: conn, attributes: attributes}
+}
+
+func (c *tracedClientConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	ctx, span := startSpan(ctx, method, trace.SpanKindClient, args, c.attributes)
+	err := c.conn.Invoke(inject(ctx), method, args, reply, opts...)
+	endSpan(span, reply, err)
+	return err
+}
+
+func (c *tracedClientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span := startSpan(ctx, method, trace.SpanKindClient, nil, c.attributes)
+	cs, err := c.conn.NewStream(inject(ctx), desc, method, opts...)
+	if err != nil {
+		endSpan(span, nil, err)
+		return nil, err
+	}
+	return &tracedClientStream{ClientStream: cs, span: span, attributes: c.attributes}, nil
+}
+
+// tracedClientStream adds attributes from the first message sent and ends the span once the
+// stream has ended
+type tracedClientStream struct {
+	grpc.ClientStream
+	span       trace.Span
+	attributes AttributesFunc
+	sendOnce   sync.Once
+	endOnce    sync.Once
+}
+
+func (s *tracedClientStream) SendMsg(m interface{}) error {
+	if s.attributes != nil {
+		s.sendOnce.Do(func() {
+			s.span.SetAttributes(s.attributes(m)...)
+		})
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *tracedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.endOnce.Do(func() {
+			if err == io.EOF {
+				endSpan(s.span, nil, nil)
+			} else {
+				endSpan(s.span, nil, err)
+			}
+		})
+	}
+	return err
+}